@@ -0,0 +1,12 @@
+// Package battleship embute os assets do jogo no binário.
+package battleship
+
+import "embed"
+
+// Assets contém a pasta assets/ inteira, com os mesmos caminhos usados no
+// código (ex.: "assets/images/mouse.png"). Com ela o jogo é um binário único,
+// que não depende da pasta de onde é executado. O acesso pelo jogo passa pelo
+// gerenciador de game/shared/assets, que guarda em cache o que já foi decodificado.
+//
+//go:embed assets
+var Assets embed.FS
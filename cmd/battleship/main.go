@@ -1,19 +1,39 @@
 package main
 
 import (
+	"flag"
+	"fmt"
+
 	"github.com/allanjose001/go-battleship/game"
 	"github.com/allanjose001/go-battleship/internal/bootstrap"
+	"github.com/allanjose001/go-battleship/internal/service"
 	"github.com/hajimehoshi/ebiten/v2"
 )
 
 func main() {
+	dataDirFlag := flag.String("data-dir", "", "diretório dos perfis, partidas salvas e replays (padrão: $"+bootstrap.DataDirEnvVar+" ou o diretório de dados do usuário)")
+	flag.Parse()
+
 	bootstrap.InitRandom()
 
+	dataDir, err := bootstrap.ResolveDataDir(*dataDirFlag)
+	if err != nil {
+		panic(err)
+	}
+	migrated, err := bootstrap.MigrateLegacyData(service.LegacyDataDir, dataDir)
+	if err != nil {
+		fmt.Println("Erro migrando dados de", service.LegacyDataDir+":", err)
+	} else if migrated {
+		fmt.Println("Perfis copiados de", service.LegacyDataDir, "para", dataDir)
+	}
+	service.SetDataDir(dataDir)
+	fmt.Println("Diretório de dados:", dataDir)
+
 	g := game.NewGame()
 	ebiten.SetWindowResizingMode(ebiten.WindowResizingModeEnabled)
 
 	if err := game.SetGameWindowIcon("assets/icons/skull.png"); err != nil {
-		panic(err)
+		fmt.Println("Ícone da janela indisponível:", err)
 	}
 
 	if err := ebiten.RunGame(g); err != nil {
@@ -0,0 +1,117 @@
+// simulate joga partidas headless entre dois perfis de IA e mostra
+// taxa de vitória, tiros até vencer e uso de cada estratégia, para que o
+// ajuste de dificuldade seja guiado por dados.
+//
+// Uso:
+//
+//	go run ./cmd/simulate -a hard -b expert -n 500
+//	go run ./cmd/simulate -a medium -b dynamic -size 12 -fleet classica -json
+//	go run ./cmd/simulate -a hard -b pirata_imprudente
+//
+// Os perfis de assets/ai_profiles vêm embutidos no binário (battleship.Assets);
+// os de <diretório de dados>/ai_profiles também entram, como no jogo
+// ($BATTLESHIP_DATA_DIR troca o diretório).
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/allanjose001/go-battleship/internal/bootstrap"
+	"github.com/allanjose001/go-battleship/internal/entity"
+	"github.com/allanjose001/go-battleship/internal/service"
+)
+
+func main() {
+	// perfis de IA e frotas próprios ficam no diretório de dados do jogo
+	if dir, err := bootstrap.ResolveDataDir(""); err == nil {
+		service.SetDataDir(dir)
+	}
+
+	names := strings.Join(configNames(), ", ")
+	a := flag.String("a", "hard", "perfil da IA A ("+names+")")
+	b := flag.String("b", "expert", "perfil da IA B ("+names+")")
+	games := flag.Int("n", 200, "número de partidas")
+	seed := flag.Int64("seed", 1, "semente da primeira partida (a partida i usa seed+i)")
+	size := flag.Int("size", entity.DefaultBoardSize, "dimensão do tabuleiro")
+	fleetID := flag.String("fleet", entity.DefaultFleetSpec.ID, "id da composição de frota")
+	noTouch := flag.Bool("notouch", false, "navios não podem se encostar")
+	asJSON := flag.Bool("json", false, "saída em JSON")
+	flag.Parse()
+
+	for _, name := range []string{*a, *b} {
+		if _, ok := service.FindAIProfile(name); !ok {
+			fmt.Fprintf(os.Stderr, "perfil de IA desconhecido %q (disponíveis: %s)\n", name, names)
+			os.Exit(2)
+		}
+	}
+
+	boardSize := entity.NormalizeBoardSize(*size)
+	spec, ok := service.FindFleetSpec(*fleetID)
+	if !ok {
+		fmt.Fprintf(os.Stderr, "frota desconhecida %q\n", *fleetID)
+		os.Exit(2)
+	}
+	spec = service.ResolveFleetSpec(spec, boardSize)
+	if *noTouch && !service.ResolveNoTouch(true, spec, boardSize) {
+		fmt.Fprintf(os.Stderr, "frota %s não cabe em %dx%d sem navios encostados: regra desligada\n", spec.ID, boardSize, boardSize)
+		*noTouch = false
+	}
+
+	rep := simulate(*a, *b, simOptions{
+		Games:     max(*games, 1),
+		Seed:      *seed,
+		BoardSize: boardSize,
+		Fleet:     spec,
+		NoTouch:   *noTouch,
+	})
+
+	if *asJSON {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		if err := enc.Encode(rep); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		return
+	}
+	printTable(os.Stdout, rep)
+}
+
+// printTable escreve o relatório em tabelas alinhadas.
+func printTable(out io.Writer, rep Report) {
+	fmt.Fprintf(out, "%d partidas, tabuleiro %dx%d, frota %s, seed %d", rep.Games, rep.BoardSize, rep.BoardSize, rep.Fleet, rep.Seed)
+	if rep.NoTouch {
+		fmt.Fprint(out, ", sem contato")
+	}
+	fmt.Fprintf(out, ", empates %d\n\n", rep.Draws)
+
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', tabwriter.AlignRight)
+	fmt.Fprintln(w, "IA\tvitórias\ttaxa\tmédia\tmediana\tp10\tp90\t")
+	for _, s := range rep.Sides {
+		fmt.Fprintf(w, "%s\t%d\t%.1f%%\t%.1f\t%.1f\t%.1f\t%.1f\t\n",
+			s.Config, s.Wins, s.WinRate*100, s.MeanShots, s.MedianShots, s.P10Shots, s.P90Shots)
+	}
+	w.Flush()
+
+	for _, s := range rep.Sides {
+		fmt.Fprintf(out, "\nestratégias de %s:\n", s.Config)
+		names := make([]string, 0, len(s.StrategyUsage))
+		for name := range s.StrategyUsage {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+
+		w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+		for _, name := range names {
+			fmt.Fprintf(w, "  %s\t%d\n", name, s.StrategyUsage[name])
+		}
+		w.Flush()
+	}
+}
@@ -0,0 +1,200 @@
+package main
+
+import (
+	"math/rand"
+	"sort"
+
+	"github.com/allanjose001/go-battleship/internal/ai"
+	"github.com/allanjose001/go-battleship/internal/entity"
+	"github.com/allanjose001/go-battleship/internal/service"
+)
+
+// configNames lista os perfis de IA disponíveis (para mensagens de uso).
+func configNames() []string {
+	profiles := service.AvailableAIProfiles()
+	names := make([]string, 0, len(profiles))
+	for _, p := range profiles {
+		names = append(names, p.ID)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// newAI cria a IA do perfil name que ataca enemyFleet; ownBoard é o tabuleiro da
+// própria IA (usado pelos perfis que movem navios).
+func newAI(name string, enemyFleet *entity.Fleet, ownBoard *entity.Board) *ai.AIPlayer {
+	profile, _ := service.FindAIProfile(name)
+	player, err := profile.Build(enemyFleet, ownBoard.Size, ownBoard)
+	if err != nil {
+		panic(err) // perfis carregados já foram validados
+	}
+	return player
+}
+
+// simOptions são os parâmetros de uma bateria de partidas.
+type simOptions struct {
+	Games     int
+	Seed      int64
+	BoardSize int
+	Fleet     entity.FleetSpec
+	NoTouch   bool
+}
+
+// side é um dos dois jogadores de uma partida simulada.
+type side struct {
+	name  string
+	ai    *ai.AIPlayer
+	board *entity.Board // tabuleiro próprio
+	fleet *entity.Fleet
+	shots int
+	usage map[string]int
+}
+
+// gameResult é o resultado de uma partida: índice do vencedor (-1 se empatou
+// no limite de turnos) e os tiros de cada lado.
+type gameResult struct {
+	winner int
+	shots  [2]int
+}
+
+// playGame joga uma partida entre as configurações a e b. As frotas vêm do
+// AIFleetService com a semente da partida e cada IA tem o próprio gerador,
+// derivado da mesma semente. first indica quem começa (0 = a).
+func playGame(a, b string, opt simOptions, seed int64, first int, usage [2]map[string]int) gameResult {
+	placer := service.NewAIFleetService(rand.New(rand.NewSource(seed)))
+	noTouch := service.ResolveNoTouch(opt.NoTouch, opt.Fleet, opt.BoardSize)
+
+	sides := [2]*side{{name: a}, {name: b}}
+	for _, s := range sides {
+		s.board, s.fleet = entity.NewBoard(opt.BoardSize), entity.NewFleetFromSpec(opt.Fleet)
+		s.board.NoTouch = noTouch
+		placer.PositionShips(s.board, s.fleet, service.NewAIPlacement(s.name, nil, opt.BoardSize))
+	}
+	for i, s := range sides {
+		enemy := sides[1-i]
+		s.ai = newAI(s.name, enemy.fleet, s.board)
+		s.ai.SetRand(rand.New(rand.NewSource(seed*2 + int64(i) + 1)))
+		s.usage = usage[i]
+	}
+
+	oracles := [2]ai.ShotOracle{ai.NewBoardOracle(sides[1].board), ai.NewBoardOracle(sides[0].board)}
+
+	// limite de ações para uma partida entre IAs dinâmicas não rodar para sempre
+	maxActions := opt.BoardSize * opt.BoardSize * 20
+	turn := first
+	for actions := 0; actions < maxActions; actions++ {
+		s, target := sides[turn], sides[1-turn]
+
+		prevShots := target.board.ShotCount()
+		strat := s.ai.Attack(oracles[turn])
+		s.usage[ai.StrategyName(strat)]++
+
+		if target.board.ShotCount() == prevShots {
+			// a estratégia moveu um navio (ou não agiu): passa a vez
+			turn = 1 - turn
+			continue
+		}
+
+		s.shots++
+		row, col := target.board.LastShot()
+		if !entity.WasHit(target.board.Positions[row][col]) {
+			turn = 1 - turn
+			continue
+		}
+		// IAs que movem navios fogem dos acertos (ver EvasionStrategy)
+		target.ai.RegisterIncomingHit(row, col)
+		// acerto: atira de novo, como na partida clássica
+		if target.fleet.IsFleetDestroyed() {
+			return gameResult{winner: turn, shots: [2]int{sides[0].shots, sides[1].shots}}
+		}
+	}
+	return gameResult{winner: -1, shots: [2]int{sides[0].shots, sides[1].shots}}
+}
+
+// SideReport são as estatísticas de uma configuração na bateria.
+type SideReport struct {
+	Config        string         `json:"config"`
+	Wins          int            `json:"wins"`
+	WinRate       float64        `json:"win_rate"`
+	MeanShots     float64        `json:"mean_shots_to_win"`
+	MedianShots   float64        `json:"median_shots_to_win"`
+	P10Shots      float64        `json:"p10_shots_to_win"`
+	P90Shots      float64        `json:"p90_shots_to_win"`
+	StrategyUsage map[string]int `json:"strategy_usage"`
+}
+
+// Report é o resultado de uma bateria de partidas entre duas configurações.
+type Report struct {
+	Games     int           `json:"games"`
+	Seed      int64         `json:"seed"`
+	BoardSize int           `json:"board_size"`
+	Fleet     string        `json:"fleet"`
+	NoTouch   bool          `json:"no_touch,omitempty"`
+	Draws     int           `json:"draws"`
+	Sides     [2]SideReport `json:"sides"`
+}
+
+// simulate joga opt.Games partidas entre a e b, alternando quem começa.
+// A partida i usa a semente opt.Seed+i, então a bateria inteira é reproduzível.
+func simulate(a, b string, opt simOptions) Report {
+	usage := [2]map[string]int{{}, {}}
+	var winShots [2][]int
+	rep := Report{
+		Games:     opt.Games,
+		Seed:      opt.Seed,
+		BoardSize: opt.BoardSize,
+		Fleet:     opt.Fleet.ID,
+		NoTouch:   opt.NoTouch,
+	}
+
+	for i := 0; i < opt.Games; i++ {
+		res := playGame(a, b, opt, opt.Seed+int64(i), i%2, usage)
+		if res.winner < 0 {
+			rep.Draws++
+			continue
+		}
+		winShots[res.winner] = append(winShots[res.winner], res.shots[res.winner])
+	}
+
+	for i, name := range []string{a, b} {
+		shots := winShots[i]
+		sort.Ints(shots)
+		rep.Sides[i] = SideReport{
+			Config:        name,
+			Wins:          len(shots),
+			WinRate:       float64(len(shots)) / float64(max(opt.Games, 1)),
+			MeanShots:     mean(shots),
+			MedianShots:   percentile(shots, 50),
+			P10Shots:      percentile(shots, 10),
+			P90Shots:      percentile(shots, 90),
+			StrategyUsage: usage[i],
+		}
+	}
+	return rep
+}
+
+func mean(values []int) float64 {
+	if len(values) == 0 {
+		return 0
+	}
+	total := 0
+	for _, v := range values {
+		total += v
+	}
+	return float64(total) / float64(len(values))
+}
+
+// percentile calcula o percentil p (0-100) de values já ordenados,
+// com interpolação linear entre as posições vizinhas.
+func percentile(sorted []int, p float64) float64 {
+	if len(sorted) == 0 {
+		return 0
+	}
+	pos := p / 100 * float64(len(sorted)-1)
+	lo := int(pos)
+	if lo >= len(sorted)-1 {
+		return float64(sorted[len(sorted)-1])
+	}
+	frac := pos - float64(lo)
+	return float64(sorted[lo]) + frac*float64(sorted[lo+1]-sorted[lo])
+}
@@ -0,0 +1,84 @@
+package components
+
+import (
+	"fmt"
+	"image/color"
+
+	"github.com/allanjose001/go-battleship/game/shared/board"
+	"github.com/allanjose001/go-battleship/internal/ai"
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/ebitenutil"
+	"github.com/hajimehoshi/ebiten/v2/vector"
+)
+
+// Cores do overlay de debug da IA (semitransparentes, por cima do tabuleiro).
+var (
+	debugMissColor  = color.RGBA{40, 90, 200, 90}
+	debugHitColor   = color.RGBA{255, 140, 0, 130}
+	debugSunkColor  = color.RGBA{150, 0, 0, 130}
+	debugQueueColor = color.RGBA{255, 230, 0, 230}
+)
+
+// AIDebugOverlay é uma ferramenta de desenvolvimento: desenha sobre o tabuleiro
+// do jogador o tabuleiro virtual da IA (água, acertos pendentes e navios
+// afundados), as células da fila de prioridade numeradas na ordem de ataque e,
+// acima do tabuleiro, a estratégia da última jogada e o modo perseguição.
+type AIDebugOverlay struct {
+	Visible bool
+}
+
+func NewAIDebugOverlay() *AIDebugOverlay {
+	return &AIDebugOverlay{}
+}
+
+// Toggle liga/desliga o overlay.
+func (o *AIDebugOverlay) Toggle() {
+	o.Visible = !o.Visible
+}
+
+// Draw desenha info sobre o tabuleiro b (o tabuleiro que a IA ataca).
+func (o *AIDebugOverlay) Draw(screen *ebiten.Image, b *board.Board, info ai.DebugInfo) {
+	if !o.Visible || b == nil {
+		return
+	}
+
+	cellSize := b.CellSize()
+	for i, row := range info.VirtualBoard {
+		for j, state := range row {
+			var c color.Color
+			switch state {
+			case ai.CellMiss:
+				c = debugMissColor
+			case ai.CellHit:
+				c = debugHitColor
+			case ai.CellSunk:
+				c = debugSunkColor
+			default:
+				continue
+			}
+			ebitenutil.DrawRect(screen, b.X+float64(j)*cellSize, b.Y+float64(i)*cellSize, cellSize, cellSize, c)
+		}
+	}
+
+	for n, cell := range info.Queue {
+		x := float32(b.X + float64(cell.Col)*cellSize)
+		y := float32(b.Y + float64(cell.Row)*cellSize)
+		vector.StrokeRect(screen, x+2, y+2, float32(cellSize)-4, float32(cellSize)-4, 2, debugQueueColor, false)
+		ebitenutil.DebugPrintAt(screen, fmt.Sprint(n+1), int(x)+5, int(y)+3)
+	}
+
+	chase := "não"
+	if info.Chasing {
+		chase = "sim"
+	}
+	last := info.LastStrategy
+	if last == "" {
+		last = "-"
+	}
+	ebitenutil.DebugPrintAt(screen,
+		fmt.Sprintf("IA: %s | perseguição: %s | fila: %d | navios vivos: %v", last, chase, len(info.Queue), info.EnemyShips),
+		int(b.X), int(b.Y)-18)
+	if info.LastNote != "" {
+		ebitenutil.DebugPrintAt(screen, info.LastNote, int(b.X), int(b.Y)-34)
+	}
+}
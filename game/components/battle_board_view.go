@@ -1,12 +1,18 @@
 package components
 
 import (
+	"image/color"
+
 	"github.com/allanjose001/go-battleship/game/shared/board"
 	"github.com/allanjose001/go-battleship/game/shared/placement"
 	"github.com/allanjose001/go-battleship/internal/entity"
 	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/ebitenutil"
 )
 
+// salvoTargetColor é o destaque semitransparente dos alvos marcados da salva.
+var salvoTargetColor = color.RGBA{255, 200, 0, 110}
+
 // BattleBoardView é o componente responsável pela visualização do tabuleiro durante a batalha.
 // Ele encapsula a lógica de desenhar os navios (para o jogador) e os marcadores de tiro (Hit/Miss).
 type BattleBoardView struct {
@@ -30,58 +36,47 @@ func NewBattleBoardView(fireAnimation *FireAnimation, hitImage, missImage *ebite
 
 // DrawBoard é o método principal de desenho.
 // Ele orquestra o desenho dos navios e dos marcadores sobre o tabuleiro.
+// Tudo que é desenhado vem do estado lógico (fleet/entityBoard); o board visual
+// só fornece a geometria e os placements só fornecem os sprites.
 // Parâmetros:
 // - screen: A imagem de destino onde o desenho será feito.
-// - b: O tabuleiro lógico (dados das células).
-// - ships: A lista de navios a serem desenhados (pode ser nil para o tabuleiro do inimigo, onde não vemos os navios).
+// - b: O tabuleiro visual (posição e tamanho na tela).
+// - ships: Sprites dos navios, na mesma ordem de fleet.Ships.
+// - fleet/entityBoard: Estado lógico do tabuleiro (posições, tiros e navios afundados).
+// - hideUndestroyed: Esconde navios ainda vivos (tabuleiro do inimigo).
 func (v *BattleBoardView) DrawBoard(screen *ebiten.Image, b *board.Board, ships []*placement.ShipPlacement, fleet *entity.Fleet, entityBoard *entity.Board, hideUndestroyed bool) {
 	// Se o tabuleiro for nil, não há nada para desenhar.
 	if b == nil {
 		return
 	}
 
-	// 1. Desenha navios, se houver uma lista de navios fornecida.
-	// Isso geralmente é usado apenas para o tabuleiro do próprio jogador.
+	// 1. Desenha os navios na posição atual do navio lógico.
 	if ships != nil && fleet != nil {
-		for i, ship := range ships {
-			// Só desenha se o navio estiver marcado como posicionado.
-			if ship.Placed {
-				// Verifica se o navio correspondente na frota lógica está afundado.
-				isSunk := false
-
-				// 1. Tenta via mapeamento espacial (entityBoard)
-				if entityBoard != nil {
-					// Usa a posição do navio para encontrar a referência lógica correta
-					if ship.Y >= 0 && ship.Y < len(entityBoard.Positions) && ship.X >= 0 && ship.X < len(entityBoard.Positions[0]) {
-						pos := entityBoard.Positions[ship.Y][ship.X]
-						entShip := entity.GetShipReference(pos)
-						if entShip != nil && entShip.IsDestroyed() {
-							isSunk = true
-						}
-					}
-				}
+		for i, logicalShip := range fleet.Ships {
+			if logicalShip == nil || i >= len(ships) || ships[i] == nil {
+				continue
+			}
 
-				// 2. Fallback: Se não encontrou via espaço (ou entityBoard nulo), tenta via índice na frota.
-				// Isso garante que se o mapeamento espacial falhar, ainda temos a correspondência por ordem/tamanho.
-				if !isSunk && fleet != nil && i < len(fleet.Ships) {
-					logicalShip := fleet.Ships[i]
-					// Só considera se o tamanho bater (proteção contra desincronia de índices)
-					if logicalShip.Size == ship.Size && logicalShip.IsDestroyed() {
-						isSunk = true
-					}
-				}
+			isSunk := logicalShip.IsDestroyed()
+			if !isSunk && hideUndestroyed {
+				continue
+			}
 
-				if isSunk {
-					// Se estiver afundado, usa a imagem de navio afundado.
-					originalImage := ship.Image
-					ship.Image = ship.SunkImage
-					DrawShip(screen, b, ship, false, ship.Orientation)
-					ship.Image = originalImage // Restaura para não afetar outros estados
-				} else if !hideUndestroyed {
-					// Caso contrário, usa a imagem normal.
-					DrawShip(screen, b, ship, false, ship.Orientation)
-				}
+			// Cópia local: o placement não é alterado pelo desenho
+			sprite := *ships[i]
+			sprite.Placed = true
+			sprite.Size = logicalShip.Size
+			sprite.X, sprite.Y = logicalShip.Col, logicalShip.Row
+			sprite.Orientation = board.Vertical
+			if logicalShip.Horizontal {
+				sprite.Orientation = board.Horizontal
 			}
+			if isSunk {
+				// Se estiver afundado, usa a imagem de navio afundado.
+				sprite.Image = sprite.SunkImage
+			}
+
+			DrawShip(screen, b, &sprite, false, sprite.Orientation)
 		}
 	}
 
@@ -90,39 +85,50 @@ func (v *BattleBoardView) DrawBoard(screen *ebiten.Image, b *board.Board, ships
 	v.DrawMarkers(screen, b, entityBoard)
 }
 
-// DrawMarkers itera sobre todas as células do tabuleiro e desenha os indicadores de tiro.
-func (v *BattleBoardView) DrawMarkers(screen *ebiten.Image, b *board.Board, entityBoard *entity.Board) {
+// DrawTargets destaca as células marcadas para a próxima salva (modo Salvo).
+func (v *BattleBoardView) DrawTargets(screen *ebiten.Image, b *board.Board, cells []entity.Cell) {
 	if b == nil {
 		return
 	}
 
-	cellSize := b.Size / float64(board.Cols)
+	cellSize := b.CellSize()
+	for _, c := range cells {
+		x := b.X + float64(c.Col)*cellSize
+		y := b.Y + float64(c.Row)*cellSize
+		ebitenutil.DrawRect(screen, x, y, cellSize, cellSize, salvoTargetColor)
+	}
+}
+
+// DrawMarkers itera sobre todas as posições do board lógico e desenha os indicadores de tiro.
+func (v *BattleBoardView) DrawMarkers(screen *ebiten.Image, b *board.Board, entityBoard *entity.Board) {
+	if b == nil || entityBoard == nil {
+		return
+	}
+
+	cellSize := b.CellSize()
 	// reutiliza uma única instância de DrawImageOptions — zero alocações por frame
 	op := &ebiten.DrawImageOptions{}
 	op.Filter = ebiten.FilterNearest
 
-	for i := 0; i < board.Rows; i++ {
-		for j := 0; j < board.Cols; j++ {
-			cell := b.Cells[i][j]
+	for i := 0; i < entityBoard.Size; i++ {
+		for j := 0; j < entityBoard.Size; j++ {
+			pos := entityBoard.Positions[i][j]
 
-			if cell.State != board.Hit && cell.State != board.Miss {
+			if !entity.IsAttacked(pos) {
 				continue
 			}
 
-			// Se a célula for um Hit, verifica se o navio naquela posição está afundado.
-			// Se estiver afundado, não desenhamos o fogo (fireAnimation/hitImage).
-			if cell.State == board.Hit && entityBoard != nil {
-				pos := entityBoard.Positions[i][j]
-				ship := entity.GetShipReference(pos)
-				if ship != nil && ship.IsDestroyed() {
-					continue // Pula o desenho do fogo se o navio estiver afundado
-				}
-			}
-
 			x := b.X + float64(j)*cellSize
 			y := b.Y + float64(i)*cellSize
 
-			if cell.State == board.Hit {
+			if entity.WasHit(pos) {
+				// Se o navio atingido ali está afundado, não desenhamos o fogo
+				// (o sprite de navio afundado já indica o acerto), mesmo que ele
+				// tenha se movido depois do tiro no modo dinâmico.
+				if ship := entity.HitShip(pos); ship != nil && ship.IsDestroyed() {
+					continue
+				}
+
 				var img *ebiten.Image
 				if v.fireAnimation != nil {
 					img = v.fireAnimation.CurrentFrame()
@@ -137,14 +143,12 @@ func (v *BattleBoardView) DrawMarkers(screen *ebiten.Image, b *board.Board, enti
 					op.GeoM.Translate(x, y)
 					screen.DrawImage(img, op)
 				}
-			} else if cell.State == board.Miss {
-				if v.missImage != nil {
-					op.GeoM.Reset()
-					iw, ih := v.missImage.Size()
-					op.GeoM.Scale(cellSize/float64(iw), cellSize/float64(ih))
-					op.GeoM.Translate(x, y)
-					screen.DrawImage(v.missImage, op)
-				}
+			} else if v.missImage != nil {
+				op.GeoM.Reset()
+				iw, ih := v.missImage.Size()
+				op.GeoM.Scale(cellSize/float64(iw), cellSize/float64(ih))
+				op.GeoM.Translate(x, y)
+				screen.DrawImage(v.missImage, op)
 			}
 		}
 	}
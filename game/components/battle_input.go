@@ -42,12 +42,12 @@ func (i *BattleInput) ClickedCell() (row, col int, ok bool) {
 	}
 
 	// Calcula o tamanho de cada célula para determinar a linha e coluna.
-	cellSize := i.board.Size / float64(board.Cols)
+	cellSize := i.board.CellSize()
 	col = int((mouseX - i.board.X) / cellSize)
 	row = int((mouseY - i.board.Y) / cellSize)
 
 	// Validação final para garantir que os índices estão dentro da matriz do tabuleiro.
-	if col < 0 || col >= board.Cols || row < 0 || row >= board.Rows {
+	if col < 0 || col >= i.board.Cols || row < 0 || row >= i.board.Rows {
 		return 0, 0, false
 	}
 
@@ -80,6 +80,19 @@ func (b *Button) SetPos(point basic.Point) {
 	b.pos = point
 }
 
+// SetLabel troca o texto do botão recriando o body (útil para botões de alternância)
+func (b *Button) SetLabel(label string) {
+	if b.label == label {
+		return
+	}
+	b.label = label
+	b.makeBody()
+
+	if b.disabled {
+		b.body.SetColor(b.disabledColor)
+	}
+}
+
 func (b *Button) GetSize() basic.Size {
 	return b.size
 }
@@ -1,11 +1,10 @@
 package components
 
 import (
-	"image/gif"
-	"os"
 	"time"
 
 	"github.com/allanjose001/go-battleship/game/components/basic"
+	"github.com/allanjose001/go-battleship/game/shared/assets"
 	"github.com/hajimehoshi/ebiten/v2"
 )
 
@@ -19,27 +18,20 @@ type GIFWidget struct {
 }
 
 func NewGIFWidget(path string, pos basic.Point, scale float64) (*GIFWidget, error) {
-	f, err := os.Open(path)
+	anim, err := assets.LoadAnimation(path)
 	if err != nil {
 		return nil, err
 	}
-	defer f.Close()
 
-	g, err := gif.DecodeAll(f)
-	if err != nil {
-		return nil, err
-	}
-
-	frames := make([]*ebiten.Image, len(g.Image))
-	delays := make([]int, len(g.Delay))
+	// frames vêm do cache do gerenciador e são compartilhados entre widgets
+	frames := anim.Frames
+	delays := anim.Delays
 	totalDuration := 0
 
 	var width, height int
 
-	for i, img := range g.Image {
-		frames[i] = ebiten.NewImageFromImage(img)
-		delays[i] = g.Delay[i]
-		totalDuration += g.Delay[i] * 10
+	for i, img := range frames {
+		totalDuration += delays[i] * 10
 		if i == 0 {
 			width = img.Bounds().Dx()
 			height = img.Bounds().Dy()
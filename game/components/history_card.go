@@ -3,10 +3,12 @@ package components
 import (
 	"fmt"
 	"image/color"
+	"strings"
 
 	"github.com/allanjose001/go-battleship/game/components/basic"
 	"github.com/allanjose001/go-battleship/game/components/basic/colors"
 	"github.com/allanjose001/go-battleship/internal/entity"
+	"github.com/allanjose001/go-battleship/internal/service"
 	"github.com/hajimehoshi/ebiten/v2"
 )
 
@@ -18,9 +20,15 @@ type HistoryCard struct {
 }
 
 func NewHistoryCard(pos basic.Point, size basic.Size, result entity.MatchResult) *HistoryCard {
+	return NewHistoryCardWithReplay(pos, size, result, nil)
+}
+
+// NewHistoryCardWithReplay cria o card com um botão "Replay" no header quando a
+// partida tem log salvo (result.ReplayID) e onReplay não é nil.
+func NewHistoryCardWithReplay(pos basic.Point, size basic.Size, result entity.MatchResult, onReplay func()) *HistoryCard {
 	return &HistoryCard{
 		pos:  pos,
-		body: buildCardContainer(pos, size, result),
+		body: buildCardContainer(pos, size, result, onReplay),
 	}
 }
 
@@ -28,15 +36,15 @@ func NewHistoryCard(pos basic.Point, size basic.Size, result entity.MatchResult)
    Estrutura Principal
 ======================= */
 
-func buildCardContainer(pos basic.Point, size basic.Size, result entity.MatchResult) StylableWidget {
+func buildCardContainer(pos basic.Point, size basic.Size, result entity.MatchResult, onReplay func()) StylableWidget {
 	return NewContainer(
 		pos, size, 20,
 		colors.SeaCyan, basic.Center, basic.Center,
-		buildCardContent(size, result),
+		buildCardContent(size, result, onReplay),
 	)
 }
 
-func buildCardContent(size basic.Size, result entity.MatchResult) Widget {
+func buildCardContent(size basic.Size, result entity.MatchResult, onReplay func()) Widget {
 	return NewContainer(
 		basic.Point{}, size, 0,
 		colors.Transparent, basic.Center, basic.Center,
@@ -44,7 +52,7 @@ func buildCardContent(size basic.Size, result entity.MatchResult) Widget {
 			basic.Point{}, 10, size,
 			basic.Center, basic.Center,
 			[]Widget{
-				buildHeader(size, result),
+				buildHeader(size, result, onReplay),
 				buildStatsSection(size, result),
 			},
 		),
@@ -55,7 +63,7 @@ func buildCardContent(size basic.Size, result entity.MatchResult) Widget {
    Header
 ======================= */
 
-func buildHeader(size basic.Size, result entity.MatchResult) Widget {
+func buildHeader(size basic.Size, result entity.MatchResult, onReplay func()) Widget {
 
 	rowSize := basic.Size{
 		W: size.W * 0.9,
@@ -86,10 +94,28 @@ func buildHeader(size basic.Size, result entity.MatchResult) Widget {
 		),
 	)
 
+	centerWidgets := []Widget{
+		NewText(basic.Point{}, fmt.Sprintf("[%s]", diffText), colors.White, 24),
+	}
+	if onReplay != nil && result.ReplayID != "" {
+		centerWidgets = append(centerWidgets, NewButton(
+			basic.Point{},
+			basic.Size{W: 90, H: 30},
+			"Replay",
+			colors.Dark,
+			nil,
+			func(bt *Button) { onReplay() },
+		))
+	}
+
 	centerBlock := NewContainer(
 		basic.Point{}, partSize, 0,
 		colors.Transparent, basic.Center, basic.Center,
-		NewText(basic.Point{}, fmt.Sprintf("[%s]", diffText), colors.White, 24),
+		NewRow(
+			basic.Point{}, 10, partSize,
+			basic.Center, basic.Center,
+			centerWidgets,
+		),
 	)
 
 	rightBlock := NewContainer(
@@ -189,7 +215,7 @@ func buildRightStats(size basic.Size, result entity.MatchResult) Widget {
 					iconRowSize,
 					resultColor),
 				buildIconRow("assets/icons/anchor.png", "PERDIDOS: ",
-					fmt.Sprintf("%02d/06", result.LostShips),
+					fmt.Sprintf("%02d/%02d", result.LostShips, fleetShips(result)),
 					iconRowSize,
 					resultColor),
 			},
@@ -201,6 +227,15 @@ func buildRightStats(size basic.Size, result entity.MatchResult) Widget {
    Utilitários
 ======================= */
 
+// fleetShips retorna o tamanho da frota da partida; históricos antigos não
+// guardavam esse dado e usavam sempre a frota padrão de 6 navios.
+func fleetShips(result entity.MatchResult) int {
+	if result.FleetShips > 0 {
+		return result.FleetShips
+	}
+	return entity.DefaultFleetSpec.ShipCount()
+}
+
 func resolveResultLabel(result entity.MatchResult) (string, color.Color) {
 	if result.Win {
 		return "VITÓRIA", colors.GoldMedal
@@ -217,6 +252,9 @@ func resolveModeLabel(result entity.MatchResult) (string, color.Color) {
 	if result.Mode == "Dinâmico" {
 		return "DINÂMICA", colors.SilverMedal
 	}
+	if result.Mode == "Salvo" {
+		return "SALVO", colors.BronzeMedal
+	}
 	return "CLÁSSICA", colors.White
 }
 
@@ -274,7 +312,15 @@ func resolveDifficultyLabel(diff string) string {
 		return "IMEDIATO"
 	case "hard":
 		return "ALMIRANTE"
+	case "expert":
+		return "LENDA"
+	case service.AdaptiveDifficulty:
+		return "ADAPTATIVA"
 	default:
+		// perfis de IA carregados de assets/ai_profiles
+		if profile, ok := service.FindAIProfile(diff); ok {
+			return strings.ToUpper(profile.Name)
+		}
 		return "RECRUTA"
 	}
 }
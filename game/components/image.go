@@ -2,8 +2,8 @@ package components
 
 import (
 	"github.com/allanjose001/go-battleship/game/components/basic"
+	"github.com/allanjose001/go-battleship/game/shared/assets"
 	"github.com/hajimehoshi/ebiten/v2"
-	"github.com/hajimehoshi/ebiten/v2/ebitenutil"
 )
 
 type Image struct {
@@ -25,11 +25,9 @@ func NewImage(path string, pos basic.Point, size basic.Size) (*Image, error) {
 	}, nil
 }
 
+// loadImage pega a imagem no gerenciador de assets (carregada uma vez por caminho)
 func loadImage(path string) (*ebiten.Image, error) {
-
-	image, _, err := ebitenutil.NewImageFromFile(path)
-
-	return image, err
+	return assets.Image(path)
 }
 
 func (i *Image) GetPos() basic.Point {
@@ -76,7 +74,7 @@ func (i *Image) Draw(screen *ebiten.Image) {
 }
 
 func (i *Image) SetTexture(path string) interface{} {
-	newImg, _, err := ebitenutil.NewImageFromFile(path)
+	newImg, err := loadImage(path)
 	if err != nil {
 		return err
 	}
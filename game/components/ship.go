@@ -29,7 +29,7 @@ func DrawShip(screen *ebiten.Image, b *board.Board, ship *placement.ShipPlacemen
 	// Caso 1: navio já está colocado no tabuleiro.
 	if ship.Placed {
 		// Cada célula do tabuleiro tem o mesmo tamanho em pixels.
-		cellSize := b.Size / float64(board.Cols)
+		cellSize := b.CellSize()
 
 		// Converte posição em células (X,Y) para coordenadas de tela.
 		x := b.X + float64(ship.X)*cellSize
@@ -72,7 +72,7 @@ func DrawShip(screen *ebiten.Image, b *board.Board, ship *placement.ShipPlacemen
 	// Caso 2: navio está sendo arrastado pelo jogador.
 	if ship.Dragging {
 		// Usa o mesmo tamanho de célula do tabuleiro para manter escala consistente.
-		cellSize := b.Size / float64(board.Cols)
+		cellSize := b.CellSize()
 		iw, ih := ship.Image.Size()
 
 		// Escala imagem proporcional ao tamanho do navio.
@@ -0,0 +1,59 @@
+package components
+
+import (
+	"image/color"
+
+	"github.com/allanjose001/go-battleship/game/components/basic"
+	"github.com/allanjose001/go-battleship/game/shared/board"
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/ebitenutil"
+)
+
+// sonarNoticeFrames é quanto tempo o aviso fica na tela (~2,5s a 60 TPS).
+const sonarNoticeFrames = 150
+
+var (
+	sonarNoticeColor      = color.RGBA{120, 255, 170, 255}
+	sonarNoticeBackground = color.RGBA{0, 40, 20, 180}
+)
+
+// SonarNotice é o aviso do HUD de que um navio inimigo mudou de posição
+// (modo dinâmico). Aparece acima do tabuleiro da IA por alguns segundos a cada Show,
+// sem dizer qual navio se moveu.
+type SonarNotice struct {
+	label  *Text
+	frames int
+}
+
+// NewSonarNotice cria o aviso centralizado acima do tabuleiro b.
+func NewSonarNotice(b *board.Board) *SonarNotice {
+	label := NewText(basic.Point{}, "SONAR: contato inimigo se moveu!", sonarNoticeColor, 18)
+	label.SetPos(basic.Point{
+		X: float32(b.X+b.Size/2) - label.GetSize().W/2,
+		Y: float32(b.Y) - 40,
+	})
+	return &SonarNotice{label: label}
+}
+
+// Show exibe o aviso (reinicia o tempo se ele já estiver na tela).
+func (n *SonarNotice) Show() {
+	n.frames = sonarNoticeFrames
+}
+
+// Update conta o tempo do aviso.
+func (n *SonarNotice) Update() {
+	if n.frames > 0 {
+		n.frames--
+	}
+	n.label.Update(basic.Point{})
+}
+
+// Draw desenha o aviso com um fundo escuro; some nos últimos quadros piscando.
+func (n *SonarNotice) Draw(screen *ebiten.Image) {
+	if n.frames == 0 || (n.frames < sonarNoticeFrames/4 && n.frames/8%2 == 0) {
+		return
+	}
+	pos, size := n.label.GetPos(), n.label.GetSize()
+	ebitenutil.DrawRect(screen, float64(pos.X)-10, float64(pos.Y)-6, float64(size.W)+20, float64(size.H)+12, sonarNoticeBackground)
+	n.label.Draw(screen)
+}
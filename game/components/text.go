@@ -2,13 +2,15 @@ package components
 
 import (
 	"github.com/allanjose001/go-battleship/game/components/basic"
+	"github.com/allanjose001/go-battleship/game/shared/assets"
 	"github.com/hajimehoshi/ebiten/v2"
 	"github.com/hajimehoshi/ebiten/v2/text"
 	"golang.org/x/image/font"
+	"golang.org/x/image/font/basicfont"
 	"golang.org/x/image/font/opentype"
 
+	"fmt"
 	"image/color"
-	"os"
 )
 
 // Text widget que desenha texto com uma font.Face já definida e carregada no incio da run do jogo
@@ -41,21 +43,23 @@ func NewText(
 	return t
 }
 
-// InitFonts carrega fonte (*opentype.Font) no inicio do jogo
+// InitFonts carrega fonte (*opentype.Font) no inicio do jogo.
+// Sem a fonte o jogo continua com a fonte bitmap padrão (ver createFace).
 func InitFonts() {
-	data, err := os.ReadFile("assets/fonts/Retro Gaming.ttf")
+	f, err := assets.Font("assets/fonts/Retro Gaming.ttf")
 	if err != nil {
-		panic("Erro ao ler arquivo ttf: " + err.Error())
-	}
-
-	globalFont, err = opentype.Parse(data)
-	if globalFont == nil {
-		panic("Fonte não inicializada. Chame InitFonts() antes de criar Text.")
+		fmt.Println("Erro carregando fonte (usando fonte padrão):", err)
+		return
 	}
+	globalFont = f
 }
 
 // createFace Cria uma Face de um tamanho específico
+// (fonte bitmap de tamanho fixo se a fonte do jogo não foi carregada)
 func createFace(size float64) font.Face {
+	if globalFont == nil {
+		return basicfont.Face7x13
+	}
 	face, _ := opentype.NewFace(globalFont, &opentype.FaceOptions{
 		Size:    size,
 		DPI:     72,
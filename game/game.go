@@ -1,11 +1,8 @@
 package game
 
 import (
-	"fmt"
 	"image"
-	"image/png"
 	"log"
-	"os"
 
 	"github.com/allanjose001/go-battleship/game/components"
 	"github.com/allanjose001/go-battleship/game/state"
@@ -14,6 +11,7 @@ import (
 	"github.com/allanjose001/go-battleship/game/components/basic"
 	"github.com/allanjose001/go-battleship/game/components/basic/colors"
 	"github.com/allanjose001/go-battleship/game/scenes"
+	"github.com/allanjose001/go-battleship/game/shared/assets"
 	"github.com/hajimehoshi/ebiten/v2"
 )
 
@@ -72,19 +70,9 @@ func (g *Game) Layout(_, _ int) (int, int) {
 	return int(windowSize.W), int(windowSize.H)
 }
 
-// SetGameWindowIcon carrega um PNG e define como ícone da janela do jogo
+// SetGameWindowIcon carrega um PNG dos assets e define como ícone da janela do jogo
 func SetGameWindowIcon(path string) error {
-	f, err := os.Open(path)
-	if err != nil {
-		return err
-	}
-	defer func() {
-		if cerr := f.Close(); cerr != nil {
-			fmt.Println("erro ao fechar arquivo:", cerr)
-		}
-	}()
-
-	img, err := png.Decode(f)
+	img, err := assets.Decode(path)
 	if err != nil {
 		return err
 	}
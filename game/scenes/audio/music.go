@@ -2,11 +2,12 @@ package audio
 
 import (
 	"bytes"
+	"fmt"
 	"log"
-	"os"
 	"sync"
 	"time"
 
+	"github.com/allanjose001/go-battleship/game/shared/assets"
 	"github.com/hajimehoshi/ebiten/v2/audio"
 	"github.com/hajimehoshi/ebiten/v2/audio/vorbis"
 )
@@ -17,40 +18,38 @@ const sampleRate = 44100
 // Music representa uma musica OGG
 // encapsula player, stream, volume e fade
 type Music struct {
-	player         *audio.Player // player de audio
-	file           *os.File
+	player         *audio.Player  // player de audio
 	stream         *vorbis.Stream // stream decodificada
 	volume         float64        // volume atual (0.0 a 1.0)
 	originalVolume float64        // volume que deve ser restaurado ao desmutar
 	lock           sync.Mutex     // lock para thread-safe
 }
 
-// NewMusic cria uma musica a partir de arquivo OGG
-func NewMusic(ctx *audio.Context, path string) *Music {
-	file, err := os.Open(path)
+// NewMusic cria uma musica a partir de um OGG dos assets
+func NewMusic(ctx *audio.Context, path string) (*Music, error) {
+	data, err := assets.Bytes(path)
 	if err != nil {
-		log.Fatal(err)
+		return nil, err
 	}
 
-	stream, err := vorbis.DecodeWithSampleRate(sampleRate, file)
+	stream, err := vorbis.DecodeWithSampleRate(sampleRate, bytes.NewReader(data))
 	if err != nil {
-		log.Fatal(err)
+		return nil, fmt.Errorf("%s: %w", path, err)
 	}
 
 	loop := audio.NewInfiniteLoop(stream, stream.Length())
 
 	player, err := ctx.NewPlayer(loop)
 	if err != nil {
-		log.Fatal(err)
+		return nil, err
 	}
 
 	return &Music{
-		file:           file,
 		stream:         stream,
 		player:         player,
 		volume:         1.0,
 		originalVolume: 1.0,
-	}
+	}, nil
 }
 
 // Play inicia ou reinicia a musica
@@ -135,15 +134,7 @@ func (m *Music) FadeTo(target float64, duration time.Duration) {
 func (m *Music) Close() error {
 	m.lock.Lock()
 	defer m.lock.Unlock()
-	err := m.player.Close()
-	if err != nil {
-		return err
-	}
-	err2 := m.file.Close()
-	if err2 != nil {
-		return err2
-	}
-	return nil
+	return m.player.Close()
 }
 
 type SFX struct {
@@ -151,27 +142,34 @@ type SFX struct {
 	ctx  *audio.Context
 }
 
-func NewSFX(ctx *audio.Context, path string) *SFX {
-	data, err := os.ReadFile(path)
+// NewSFX carrega um efeito sonoro OGG dos assets (decodificado a cada Play)
+func NewSFX(ctx *audio.Context, path string) (*SFX, error) {
+	data, err := assets.Bytes(path)
 	if err != nil {
-		log.Fatal(err)
+		return nil, err
+	}
+	// valida o arquivo já no carregamento, para Play não falhar depois
+	if _, err := vorbis.DecodeWithSampleRate(sampleRate, bytes.NewReader(data)); err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
 	}
 
 	return &SFX{
 		data: data,
 		ctx:  ctx,
-	}
+	}, nil
 }
 
 func (s *SFX) Play(vol float64) {
 	stream, err := vorbis.DecodeWithSampleRate(sampleRate, bytes.NewReader(s.data))
 	if err != nil {
-		log.Fatal(err)
+		fmt.Println("Erro tocando efeito sonoro:", err)
+		return
 	}
 
 	player, err := s.ctx.NewPlayer(stream)
 	if err != nil {
-		log.Fatal(err)
+		fmt.Println("Erro tocando efeito sonoro:", err)
+		return
 	}
 
 	player.SetVolume(vol)
@@ -1,6 +1,7 @@
 package audio
 
 import (
+	"fmt"
 	"math/rand"
 	"sync"
 	"time"
@@ -30,17 +31,30 @@ func NewSoundService() *SoundService {
 }
 
 // LoadMusic carrega musica para o servico //name=identificador
+// se o arquivo faltar o jogo segue sem essa musica (Play vira no-op)
 func (ss *SoundService) LoadMusic(name, path string) {
+	m, err := NewMusic(ss.ctx, path)
+	if err != nil {
+		fmt.Printf("Música %q indisponível: %v\n", name, err)
+		return
+	}
+
 	ss.lock.Lock()
 	defer ss.lock.Unlock()
-	ss.musics[name] = NewMusic(ss.ctx, path)
+	ss.musics[name] = m
 }
 
+// LoadSFX carrega um efeito; se o arquivo faltar o efeito fica mudo
 func (ss *SoundService) LoadSFX(name, path string) {
+	sfx, err := NewSFX(ss.ctx, path)
+	if err != nil {
+		fmt.Printf("Efeito sonoro %q indisponível: %v\n", name, err)
+		return
+	}
+
 	ss.lock.Lock()
 	defer ss.lock.Unlock()
-
-	ss.sfx[name] = append(ss.sfx[name], NewSFX(ss.ctx, path))
+	ss.sfx[name] = append(ss.sfx[name], sfx)
 }
 
 // Play toca musica //loop=true repete //fade entre musicas
@@ -4,7 +4,7 @@ import (
 	"sync"
 
 	"github.com/allanjose001/go-battleship/game/components"
-	"github.com/allanjose001/go-battleship/internal/assets"
+	"github.com/allanjose001/go-battleship/game/shared/assets"
 	"github.com/hajimehoshi/ebiten/v2"
 )
 
@@ -64,3 +64,43 @@ func LoadBattleAssets() *BattleAssets {
 
 	return cachedBattleAssets
 }
+
+// loadShipSprites carrega os sprites dos navios (normal e afundado) por tamanho de sprite.
+func loadShipSprites() map[int]shipSprite {
+	img1 := assets.OptionalImage("assets/images/1 slot 1.png")
+	img2 := assets.OptionalImage("assets/images/3 slots 2.png")
+	img3 := assets.OptionalImage("assets/images/Frame 400.png")
+	img4 := assets.OptionalImage("assets/images/NAVIO 4 SLOTS 1.png")
+
+	battleAssets := LoadBattleAssets()
+
+	return map[int]shipSprite{
+		1: {image: img1, sunk: battleAssets.SunkShip1},
+		3: {image: img2, sunk: battleAssets.SunkShip2},
+		4: {image: img4, sunk: battleAssets.SunkShip4},
+		6: {image: img3, sunk: battleAssets.SunkShip3},
+	}
+}
+
+// shipSprite agrupa o sprite normal e o de navio afundado de uma classe de navio.
+type shipSprite struct {
+	image, sunk *ebiten.Image
+}
+
+// closestShipSprite escolhe o menor sprite que comporta o tamanho pedido (ou o maior disponível).
+// O sprite é escalado para o número de células ao ser desenhado no tabuleiro.
+func closestShipSprite(sprites map[int]shipSprite, size int) shipSprite {
+	best, largest := -1, -1
+	for s := range sprites {
+		if s >= size && (best == -1 || s < best) {
+			best = s
+		}
+		if s > largest {
+			largest = s
+		}
+	}
+	if best == -1 {
+		best = largest
+	}
+	return sprites[best]
+}
@@ -7,12 +7,16 @@ import (
 	"github.com/allanjose001/go-battleship/game/components"
 	"github.com/allanjose001/go-battleship/game/components/basic"
 	"github.com/allanjose001/go-battleship/game/components/basic/colors"
+	"github.com/allanjose001/go-battleship/game/shared/assets"
 	"github.com/allanjose001/go-battleship/internal/entity"
 	"github.com/allanjose001/go-battleship/internal/service"
 	"github.com/hajimehoshi/ebiten/v2"
-	"github.com/hajimehoshi/ebiten/v2/ebitenutil"
+	"github.com/hajimehoshi/ebiten/v2/inpututil"
 )
 
+// debugOverlayKey liga/desliga o overlay de debug da IA na batalha.
+const debugOverlayKey = ebiten.KeyF3
+
 // BattleScene representa a tela de batalha em si.
 // Ela coordena o serviço de batalha, o renderer e os botões de interface.
 type BattleScene struct {
@@ -30,6 +34,12 @@ type BattleScene struct {
 	boardView *components.BattleBoardView
 	divider   *components.VerticalDivider
 
+	// salvoLabel mostra os alvos marcados da salva (só no modo Salvo)
+	salvoLabel *components.Text
+
+	// debugOverlay mostra o raciocínio da IA sobre o tabuleiro do jogador (F3)
+	debugOverlay *components.AIDebugOverlay
+
 	// Estado da Série
 	matchIndex        int
 	seriesScorePlayer int
@@ -57,24 +67,23 @@ func (s *BattleScene) SetSeriesState(index, pWins, eWins int) {
 // OnEnter é chamado quando a cena de batalha entra em foco.
 // Aqui configuramos o fundo dos tabuleiros, inicializamos o MatchService e criamos o botão de recomeçar.
 func (s *BattleScene) OnEnter(prev Scene, size basic.Size) { 
-	if s.ctx == nil || s.ctx.Match == nil {
+	if s.ctx == nil || s.ctx.Match == nil || s.ctx.Battle == nil {
 		return
 	}
 
 	match := s.ctx.Match
-	playerBoard := match.PlayerBoard
-	aiBoard := match.EnemyBoard
+	playerBoard := s.ctx.Battle.PlayerBoard
+	aiBoard := s.ctx.Battle.AIBoard
 
 	if s.ctx.BattleService != nil {
 		s.battleSvc = s.ctx.BattleService
-	} else if svc, err := service.NewBattleServiceFromMatch(match, s.ctx.IsCampaign, s.ctx.SoundService); err == nil {
+	} else if svc, err := service.NewBattleServiceFromMatch(match, s.ctx.IsCampaign, s.ctx.SoundService, s.ctx.Profiles); err == nil {
 		s.battleSvc = svc
 		s.ctx.SetBattleService(svc)
 	}
 
 	// Fundo compartilhado para os dois tabuleiros
-	bg, _, errBg := ebitenutil.NewImageFromFile("assets/images/Mask group.png")
-	if errBg == nil {
+	if bg := assets.OptionalImage("assets/images/Mask group.png"); bg != nil {
 		playerBoard.BackgroundImage = bg
 		aiBoard.BackgroundImage = bg
 	}
@@ -100,6 +109,8 @@ func (s *BattleScene) OnEnter(prev Scene, size basic.Size) {
 				colors.White,
 				func(b *components.Button) {
 					if match.Profile != nil {
+						// recomeçar abandona a partida: ela não fica salva para continuar
+						_ = service.DeleteMatchInProgress(match.Profile.Username)
 						s.ctx.SoundService.PlaySFX("backclick", 0.8)
 						SwitchTo(NewPlacementSceneWithProfile(match.Profile))
 					} else {
@@ -177,6 +188,8 @@ func (s *BattleScene) OnEnter(prev Scene, size basic.Size) {
 			aiName = "Imediato Bot"
 		case "hard":
 			aiName = "Almirante Bot"
+		case "expert":
+			aiName = "Lenda Bot"
 		}
 	}
 
@@ -205,6 +218,16 @@ func (s *BattleScene) OnEnter(prev Scene, size basic.Size) {
 	s.playerHUD = playerHUD
 	s.aiHUD = aiHUD
 	s.inputCtrl = components.NewBattleInput(aiBoard)
+
+	s.salvoLabel = nil
+	if match.IsSalvoMode {
+		s.salvoLabel = components.NewText(
+			basic.Point{X: float32(aiBoard.X), Y: float32(aiBoard.Y - 40)},
+			"",
+			colors.White,
+			18,
+		)
+	}
 	_ = s.Update()
 	s.stack.ctx.CanPopOrPush = true
 }
@@ -219,6 +242,7 @@ func (s *BattleScene) OnExit(next Scene) {
 // Se algum jogador vencer, a cena muda para a tela de Game Over.
 func (s *BattleScene) Update() error {
 	s.backButtonContainer.Update(basic.Point{})
+	s.updateDebugOverlay()
 
 	// atualiza HUDs
 	if s.playerHUD != nil {
@@ -227,6 +251,7 @@ func (s *BattleScene) Update() error {
 	if s.aiHUD != nil {
 		s.aiHUD.Update(basic.Point{})
 	}
+	s.updateSalvoLabel()
 
 	if s.battleSvc == nil {
 		return nil
@@ -250,6 +275,28 @@ func (s *BattleScene) Update() error {
 	return nil
 }
 
+// updateDebugOverlay liga/desliga o overlay de debug da IA com a tecla F3
+// (ferramenta de desenvolvimento).
+func (s *BattleScene) updateDebugOverlay() {
+	if !inpututil.IsKeyJustPressed(debugOverlayKey) {
+		return
+	}
+	if s.debugOverlay == nil {
+		s.debugOverlay = components.NewAIDebugOverlay()
+	}
+	s.debugOverlay.Toggle()
+}
+
+// updateSalvoLabel atualiza o contador de alvos da salva do jogador.
+func (s *BattleScene) updateSalvoLabel() {
+	if s.salvoLabel == nil || s.battleSvc == nil {
+		return
+	}
+	selected, shots := s.battleSvc.SalvoStatus()
+	s.salvoLabel.Text = fmt.Sprintf("SALVA: %d/%d alvos marcados", selected, shots)
+	s.salvoLabel.Update(basic.Point{})
+}
+
 // handleMatchEnd centraliza a lógica de fim de jogo e fluxo de campanha
 func (s *BattleScene) handleMatchEnd(res *entity.MatchResult) {
 	// finalRes aponta para res por padrão (modo clássico)
@@ -264,7 +311,7 @@ func (s *BattleScene) handleMatchEnd(res *entity.MatchResult) {
 		}
 
 		// Chama o serviço para processar o resultado e acumular estatísticas
-		cs := service.NewCampaignService(nil)
+		cs := service.NewCampaignService(nil, s.ctx.Profiles)
 		aggRes, isOver, err := cs.HandleCampaignResult(
 			s.ctx.Profile.Username,
 			s.ctx.Difficulty,
@@ -321,12 +368,13 @@ func (s *BattleScene) handleMatchEnd(res *entity.MatchResult) {
 
 // Draw desenha o estado atual da batalha e o botão de recomeçar.
 func (s *BattleScene) Draw(screen *ebiten.Image) {
-	if s.ctx == nil || s.ctx.Match == nil {
+	if s.ctx == nil || s.ctx.Match == nil || s.ctx.Battle == nil {
 		return
 	}
 	match := s.ctx.Match
-	playerBoard := match.PlayerBoard
-	aiBoard := match.EnemyBoard
+	battle := s.ctx.Battle
+	playerBoard := battle.PlayerBoard
+	aiBoard := battle.AIBoard
 
 	playerBoard.Draw(screen)
 	aiBoard.Draw(screen)
@@ -343,8 +391,17 @@ func (s *BattleScene) Draw(screen *ebiten.Image) {
 	}
 
 	if s.boardView != nil {
-		s.boardView.DrawBoard(screen, playerBoard, match.PlayerShips, match.PlayerFleet, match.PlayerEntityBoard, false)
-		s.boardView.DrawBoard(screen, aiBoard, match.EnemyShips, match.EnemyFleet, match.EnemyEntityBoard, true)
+		s.boardView.DrawBoard(screen, playerBoard, battle.PlayerShips, match.PlayerFleet, match.PlayerEntityBoard, false)
+		s.boardView.DrawBoard(screen, aiBoard, battle.AIShips, match.EnemyFleet, match.EnemyEntityBoard, true)
+		if match.IsSalvoMode {
+			s.boardView.DrawTargets(screen, aiBoard, match.PendingShots)
+		}
+	}
+	if s.debugOverlay != nil && s.battleSvc != nil {
+		s.debugOverlay.Draw(screen, playerBoard, s.battleSvc.AIDebug())
+	}
+	if s.salvoLabel != nil {
+		s.salvoLabel.Draw(screen)
 	}
 
 	s.backButtonContainer.Draw(screen)
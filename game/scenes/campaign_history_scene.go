@@ -7,7 +7,6 @@ import (
 	"github.com/allanjose001/go-battleship/game/components/basic"
 	"github.com/allanjose001/go-battleship/game/components/basic/colors"
 	"github.com/allanjose001/go-battleship/internal/entity"
-	"github.com/allanjose001/go-battleship/internal/service"
 	"github.com/hajimehoshi/ebiten/v2"
 )
 
@@ -39,7 +38,7 @@ func (s *CampaignHistoryScene) OnEnter(prev Scene, size basic.Size) {
 func (s *CampaignHistoryScene) init(size basic.Size) {
 	// Atualiza o perfil com os dados mais recentes do serviço
 	if s.ctx != nil && s.ctx.Profile != nil {
-		if p, err := service.FindProfile(s.ctx.Profile.Username); err == nil {
+		if p, err := s.ctx.Profiles.Find(s.ctx.Profile.Username); err == nil {
 			s.ctx.Profile = p
 		}
 	}
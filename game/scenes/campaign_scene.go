@@ -9,7 +9,6 @@ import (
 	"github.com/allanjose001/go-battleship/game/components/basic"
 	"github.com/allanjose001/go-battleship/game/components/basic/colors"
 	"github.com/allanjose001/go-battleship/internal/entity"
-	"github.com/allanjose001/go-battleship/internal/service"
 	"github.com/hajimehoshi/ebiten/v2"
 )
 
@@ -53,11 +52,13 @@ func (c *CampaignScene) refreshUI(size basic.Size) {
 		"easy":   "current", // Padrão: começa no easy
 		"medium": "locked",
 		"hard":   "locked",
+		"expert": "locked",
 	}
 	results := map[string]*entity.MatchResult{
 		"easy":   nil,
 		"medium": nil,
 		"hard":   nil,
+		"expert": nil,
 	}
 
 	if profile.CurrentCampaign != nil {
@@ -78,9 +79,16 @@ func (c *CampaignScene) refreshUI(size basic.Size) {
 		// Verifica Hard
 		if res, ok := profile.CurrentCampaign.DifficultyStep["hard"]; ok && res.Win {
 			states["hard"] = "done"
+			states["expert"] = "current"
 			results["hard"] = &res
 			totalScore += res.Score
 		}
+		// Verifica Expert
+		if res, ok := profile.CurrentCampaign.DifficultyStep["expert"]; ok && res.Win {
+			states["expert"] = "done"
+			results["expert"] = &res
+			totalScore += res.Score
+		}
 	}
 
 	// 2. Construir UI
@@ -93,6 +101,7 @@ func (c *CampaignScene) refreshUI(size basic.Size) {
 		{"easy", "Recruta"},
 		{"medium", "Imediato"},
 		{"hard", "Almirante"},
+		{"expert", "Lenda"},
 	}
 
 	var cards []components.Widget
@@ -191,7 +200,7 @@ func (c *CampaignScene) createStageCard(title, diff, state string, res *entity.M
 						DifficultyStep: make(map[string]entity.MatchResult),
 						IsActive:       true,
 					}
-					_ = service.UpdateProfile(*c.ctx.Profile)
+					_ = c.ctx.Profiles.Save(*c.ctx.Profile)
 				}
 				// Configura dificuldade no contexto e vai para posicionamento
 				c.ctx.SetDifficulty(diff)
@@ -236,7 +245,7 @@ func (c *CampaignScene) createStageCard(title, diff, state string, res *entity.M
 						DifficultyStep: make(map[string]entity.MatchResult),
 						IsActive:       true,
 					}
-					_ = service.UpdateProfile(*c.ctx.Profile)
+					_ = c.ctx.Profiles.Save(*c.ctx.Profile)
 				}
 				// Configura dificuldade no contexto e vai para posicionamento
 				c.ctx.SetDifficulty(diff)
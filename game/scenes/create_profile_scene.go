@@ -5,7 +5,6 @@ import (
 	"github.com/allanjose001/go-battleship/game/components/basic"
 	"github.com/allanjose001/go-battleship/game/components/basic/colors"
 	"github.com/allanjose001/go-battleship/internal/entity"
-	"github.com/allanjose001/go-battleship/internal/service"
 	"github.com/hajimehoshi/ebiten/v2"
 )
 
@@ -69,7 +68,7 @@ func (s *CreateProfileScene) OnEnter(prev Scene, size basic.Size) {
 				Username: username,
 			}
 
-			err := service.SaveProfile(profile)
+			err := s.ctx.Profiles.Save(profile)
 			if err != nil {
 				s.errorText.Text = "Erro ao salvar perfil"
 				return
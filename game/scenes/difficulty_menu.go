@@ -5,9 +5,14 @@ import (
 	"github.com/allanjose001/go-battleship/game/components/basic"
 	"github.com/allanjose001/go-battleship/game/components/basic/colors"
 	"github.com/allanjose001/go-battleship/internal/entity"
+	"github.com/allanjose001/go-battleship/internal/service"
 	"github.com/hajimehoshi/ebiten/v2"
 )
 
+// maxCustomProfileButtons é quantos perfis de IA extras cabem na linha da tela
+// (ao lado do botão da dificuldade adaptativa).
+const maxCustomProfileButtons = 2
+
 type DifficultyScene struct {
 	layout components.LayoutWidget
 	StackHandler
@@ -57,6 +62,49 @@ func (d *DifficultyScene) init(size basic.Size) {
 		},
 	)
 
+	btnLenda := components.NewButton(
+		basic.Point{},
+		btnSize,
+		"Lenda",
+		colors.Blue,
+		colors.White,
+		func(b *components.Button) {
+			d.ctx.SoundService.PlaySFX("click", 0.8)
+			d.selectDifficulty("expert")
+		},
+	)
+
+	// dificuldade adaptativa e perfis de IA extras (assets/ai_profiles),
+	// numa linha abaixo das dificuldades
+	custom := []components.Widget{components.NewButton(
+		basic.Point{},
+		basic.Size{W: 300, H: 50},
+		"Adaptativa",
+		colors.Dark,
+		colors.White,
+		func(b *components.Button) {
+			d.ctx.SoundService.PlaySFX("click", 0.8)
+			d.selectDifficulty(service.AdaptiveDifficulty)
+		},
+	)}
+	for i, profile := range service.CustomAIProfiles() {
+		if i == maxCustomProfileButtons {
+			break
+		}
+		id := profile.ID
+		custom = append(custom, components.NewButton(
+			basic.Point{},
+			basic.Size{W: 300, H: 50},
+			profile.Name,
+			colors.Dark,
+			colors.White,
+			func(b *components.Button) {
+				d.ctx.SoundService.PlaySFX("click", 0.8)
+				d.selectDifficulty(id)
+			},
+		))
+	}
+
 	btnVoltar := components.NewButton(
 		basic.Point{},
 		basic.Size{W: 220, H: 50},
@@ -77,7 +125,7 @@ func (d *DifficultyScene) init(size basic.Size) {
 		nil,
 	)
 	spacer2 := components.NewContainer(
-		basic.Point{}, basic.Size{W: 1, H: 100}, 0,
+		basic.Point{}, basic.Size{W: 1, H: 30}, 0,
 		colors.Transparent, basic.Center, basic.Center,
 		nil,
 	)
@@ -97,6 +145,17 @@ func (d *DifficultyScene) init(size basic.Size) {
 			btnImediato,
 			spacer,
 			btnAlmirante,
+			spacer,
+			btnLenda,
+			spacer,
+			components.NewRow(
+				basic.Point{},
+				20,
+				basic.Size{W: size.W, H: 50},
+				basic.Center,
+				basic.Center,
+				custom,
+			),
 			spacer2,
 			btnVoltar,
 		},
@@ -6,7 +6,6 @@ import (
 
 	"github.com/allanjose001/go-battleship/game/components"
 	"github.com/allanjose001/go-battleship/game/components/basic"
-	"github.com/allanjose001/go-battleship/game/shared/board"
 	"github.com/allanjose001/go-battleship/internal/entity"
 	"github.com/allanjose001/go-battleship/internal/service"
 	"github.com/hajimehoshi/ebiten/v2"
@@ -21,17 +20,19 @@ type DynamicBattleScene struct {
 	dynamicBattleSvc service.DynamicBattleService
 	playerInputCtrl  *components.BattleInput
 	selectedShip     *entity.Ship
-	selectedShipIdx  int // índice no match.PlayerShips para sincronização visual
+
+	// sonarNotice avisa quando a IA move um navio; seenEnemyMoves é a última
+	// contagem de movimentos da IA já avisada.
+	sonarNotice    *components.SonarNotice
+	seenEnemyMoves int
 }
 
 func NewDynamicBattleScene() *DynamicBattleScene {
-	return &DynamicBattleScene{
-		selectedShipIdx: -1, // Começa com nenhum navio selecionado
-	}
+	return &DynamicBattleScene{}
 }
 
 func (s *DynamicBattleScene) OnEnter(prev Scene, size basic.Size) {
-	if s.ctx == nil || s.ctx.Match == nil {
+	if s.ctx == nil || s.ctx.Match == nil || s.ctx.Battle == nil {
 		return
 	}
 
@@ -39,7 +40,14 @@ func (s *DynamicBattleScene) OnEnter(prev Scene, size basic.Size) {
 
 	// Cria o DynamicBattleService ANTES de chamar BattleScene.OnEnter,
 	// para que o pai não instancie um serviço comum (sem ownBoard).
-	svc, err := service.NewDynamicBattleServiceFromMatch(match, s.ctx.IsCampaign, s.ctx.SoundService)
+	// Partida retomada do save já chega com o serviço (e a IA restaurada) no contexto.
+	var svc service.DynamicBattleService
+	var err error
+	if resumed, ok := s.ctx.BattleService.(service.DynamicBattleService); ok {
+		svc = resumed
+	} else {
+		svc, err = service.NewDynamicBattleServiceFromMatch(match, s.ctx.IsCampaign, s.ctx.SoundService, s.ctx.Profiles)
+	}
 	if err == nil {
 		s.dynamicBattleSvc = svc
 		s.battleSvc = svc         // compatibilidade com BattleScene
@@ -50,18 +58,27 @@ func (s *DynamicBattleScene) OnEnter(prev Scene, size basic.Size) {
 	s.BattleScene.OnEnter(prev, size)
 
 	// Controlador de entrada para o tabuleiro do jogador (para seleção)
-	s.playerInputCtrl = components.NewBattleInput(match.PlayerBoard)
+	s.playerInputCtrl = components.NewBattleInput(s.ctx.Battle.PlayerBoard)
+
+	s.sonarNotice = components.NewSonarNotice(s.ctx.Battle.AIBoard)
+	if s.dynamicBattleSvc != nil {
+		s.seenEnemyMoves = s.dynamicBattleSvc.EnemyMoveCount()
+	}
 }
 
 func (s *DynamicBattleScene) Update() error {
 	// 1. Atualiza elementos base (HUDs, botões, etc)
 	s.backButtonContainer.Update(basic.Point{})
+	s.updateDebugOverlay()
 	if s.playerHUD != nil {
 		s.playerHUD.Update(basic.Point{})
 	}
 	if s.aiHUD != nil {
 		s.aiHUD.Update(basic.Point{})
 	}
+	if s.sonarNotice != nil {
+		s.sonarNotice.Update()
+	}
 
 	if s.dynamicBattleSvc == nil {
 		return nil
@@ -93,7 +110,9 @@ func (s *DynamicBattleScene) Update() error {
 	}
 
 	// 3. Lógica de Turno da IA
-	if res, err := s.dynamicBattleSvc.HandleEnemyTurn(); err == nil && res != nil {
+	res, err := s.dynamicBattleSvc.HandleEnemyTurn()
+	s.checkEnemyMoves()
+	if err == nil && res != nil {
 		s.handleMatchEnd(res)
 		return nil
 	}
@@ -101,6 +120,15 @@ func (s *DynamicBattleScene) Update() error {
 	return nil
 }
 
+// checkEnemyMoves mostra o aviso de sonar quando a IA moveu um navio desde a última checagem.
+func (s *DynamicBattleScene) checkEnemyMoves() {
+	moves := s.dynamicBattleSvc.EnemyMoveCount()
+	if moves > s.seenEnemyMoves && s.sonarNotice != nil {
+		s.sonarNotice.Show()
+	}
+	s.seenEnemyMoves = moves
+}
+
 func (s *DynamicBattleScene) handleShipSelection(row, col int) {
 	match := s.ctx.Match
 	if match.PlayerEntityBoard == nil {
@@ -120,33 +148,9 @@ func (s *DynamicBattleScene) handleShipSelection(row, col int) {
 		}
 
 		s.selectedShip = ship
-
-		// Encontra a posição top-left atual do navio no board lógico
-		minR, minC := 10, 10
-		for r := 0; r < entity.BoardSize; r++ {
-			for c := 0; c < entity.BoardSize; c++ {
-				if entity.GetShipReference(match.PlayerEntityBoard.Positions[r][c]) == ship {
-					if r < minR {
-						minR = r
-					}
-					if c < minC {
-						minC = c
-					}
-				}
-			}
-		}
-
-		// Encontra o índice correspondente no match.PlayerShips para sincronização visual
-		for i, ps := range match.PlayerShips {
-			if ps != nil && ps.X == minC && ps.Y == minR {
-				s.selectedShipIdx = i
-				break
-			}
-		}
-		fmt.Printf("Navio selecionado: %s em %d,%d\n", ship.Name, minR, minC)
+		fmt.Printf("Navio selecionado: %s em %d,%d\n", ship.Name, ship.Row, ship.Col)
 	} else {
 		s.selectedShip = nil
-		s.selectedShipIdx = -1
 	}
 }
 
@@ -166,46 +170,17 @@ func (s *DynamicBattleScene) handleShipMovement() error {
 		return nil
 	}
 
-	match := s.ctx.Match
-	// Precisamos encontrar a posição top-left atual do navio para o serviço
-	var minR, minC int = 10, 10
-	found := false
-	for r := 0; r < entity.BoardSize; r++ {
-		for c := 0; c < entity.BoardSize; c++ {
-			if entity.GetShipReference(match.PlayerEntityBoard.Positions[r][c]) == s.selectedShip {
-				if r < minR {
-					minR = r
-				}
-				if c < minC {
-					minC = c
-				}
-				found = true
-			}
-		}
-	}
+	// O navio lógico guarda a própria posição top-left
+	newRow := s.selectedShip.Row + dr
+	newCol := s.selectedShip.Col + dc
 
-	if !found {
-		return fmt.Errorf("navio não encontrado no tabuleiro")
-	}
-
-	newRow := minR + dr
-	newCol := minC + dc
-
-	// Tenta mover via serviço
+	// Tenta mover via serviço; o DrawBoard lê a nova posição direto do navio lógico
 	if err := s.dynamicBattleSvc.MovePlayerShip(s.selectedShip, newRow, newCol); err != nil {
 		return err
 	}
 
-	// Sincronização Visual: Atualiza o ShipPlacement para o DrawBoard refletir a nova posição
-	if s.selectedShipIdx >= 0 && s.selectedShipIdx < len(match.PlayerShips) {
-		ps := match.PlayerShips[s.selectedShipIdx]
-		ps.X = newCol
-		ps.Y = newRow
-	}
-
 	// Desmarca o navio após o movimento
 	s.selectedShip = nil
-	s.selectedShipIdx = -1
 
 	fmt.Printf("Navio movido para %d, %d\n", newRow, newCol)
 	return nil
@@ -214,25 +189,28 @@ func (s *DynamicBattleScene) handleShipMovement() error {
 func (s *DynamicBattleScene) Draw(screen *ebiten.Image) {
 	// Reutiliza o Draw da BattleScene
 	s.BattleScene.Draw(screen)
+	if s.sonarNotice != nil {
+		s.sonarNotice.Draw(screen)
+	}
 
 	// Adiciona destaque para o navio selecionado, se houver
-	if s.selectedShip != nil && s.selectedShipIdx >= 0 {
-		match := s.ctx.Match
-		ps := match.PlayerShips[s.selectedShipIdx]
-		cellSize := match.PlayerBoard.Size / float64(board.Cols)
+	if s.selectedShip != nil {
+		playerBoard := s.ctx.Battle.PlayerBoard
+		ship := s.selectedShip
+		cellSize := playerBoard.CellSize()
 
 		// Desenha um retângulo de seleção ao redor do navio
 		rectW := cellSize
 		rectH := cellSize
-		if ps.Orientation == board.Horizontal {
-			rectW *= float64(ps.Size)
+		if ship.Horizontal {
+			rectW *= float64(ship.Size)
 		} else {
-			rectH *= float64(ps.Size)
+			rectH *= float64(ship.Size)
 		}
 
 		ebitenutil.DrawRect(screen,
-			match.PlayerBoard.X+float64(ps.X)*cellSize,
-			match.PlayerBoard.Y+float64(ps.Y)*cellSize,
+			playerBoard.X+float64(ship.Col)*cellSize,
+			playerBoard.Y+float64(ship.Row)*cellSize,
 			rectW, rectH,
 			color.RGBA{255, 255, 255, 100})
 	}
@@ -89,6 +89,7 @@ func (s *GameOverScene) setupGameOverLayout(size basic.Size) {
 			fmt.Sprintf("Disparos: %d", s.result.PlayerShots),
 			fmt.Sprintf("Maior Sequência: %d", s.result.HigherHitSequence),
 			fmt.Sprintf("Navios Perdidos: %d", s.result.LostShips),
+			fmt.Sprintf("Navios Afundados: %d", s.result.KilledShips),
 			fmt.Sprintf("Acertos: %d", s.result.Hits),
 			fmt.Sprintf("Duração: %s", s.result.FormattedDuration()),
 		}
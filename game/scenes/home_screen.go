@@ -8,12 +8,14 @@ import (
 	"github.com/allanjose001/go-battleship/game/components/basic"
 	"github.com/allanjose001/go-battleship/game/components/basic/colors"
 	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/ebitenutil"
 )
 
 type HomeScreen struct {
 	layout       components.LayoutWidget
 	muteButton   *components.IconButton
-	StackHandler //faz composição (recebe os fields e metodos
+	notice       *components.Text // aviso sobre o carregamento dos perfis (ex.: recuperação de backup)
+	StackHandler                  //faz composição (recebe os fields e metodos
 }
 
 func (m *HomeScreen) GetMusic() string {
@@ -56,6 +58,11 @@ func (m *HomeScreen) Draw(screen *ebiten.Image) {
 	if m.muteButton != nil {
 		m.muteButton.Draw(screen) // desenha o botão fixo no canto inferior esquerdo
 	}
+	if m.notice != nil {
+		pos, size := m.notice.GetPos(), m.notice.GetSize()
+		ebitenutil.DrawRect(screen, float64(pos.X)-10, float64(pos.Y)-6, float64(size.W)+20, float64(size.H)+12, colors.DeepWater)
+		m.notice.Draw(screen)
+	}
 }
 
 func (m *HomeScreen) toggleMute() {
@@ -145,6 +152,16 @@ func (m *HomeScreen) init(screenSize basic.Size) error {
 		},
 	)
 
+	// aviso dos perfis aparece só na primeira vez que a tela é montada
+	m.notice = nil
+	if msg := m.ctx.Profiles.TakeNotice(); msg != "" {
+		m.notice = components.NewText(basic.Point{}, msg, colors.GoldMedal, 16)
+		m.notice.SetPos(basic.Point{
+			X: screenSize.W/2 - m.notice.GetSize().W/2,
+			Y: screenSize.H - 40,
+		})
+	}
+
 	// ➤ Atualize também o Update para o botão fixo
 	if m.layout != nil {
 		m.layout.Update(basic.Point{X: 0, Y: 0})
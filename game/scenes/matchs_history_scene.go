@@ -1,10 +1,13 @@
 package scenes
 
 import (
+	"fmt"
+
 	"github.com/allanjose001/go-battleship/game/components"
 	"github.com/allanjose001/go-battleship/game/components/basic"
 	"github.com/allanjose001/go-battleship/game/components/basic/colors"
 	"github.com/allanjose001/go-battleship/internal/entity"
+	"github.com/allanjose001/go-battleship/internal/service"
 	"github.com/hajimehoshi/ebiten/v2"
 )
 
@@ -142,10 +145,19 @@ func (m *MatchsHistory) init(screenSize basic.Size) {
 
 	var cards []components.Widget
 	for _, match := range pageMatches {
-		card := components.NewHistoryCard(
+		replayID := match.ReplayID
+		card := components.NewHistoryCardWithReplay(
 			basic.Point{},
 			basic.Size{W: screenSize.W * 0.8, H: 265},
 			match,
+			func() {
+				rec, err := service.LoadMatchRecord(replayID)
+				if err != nil {
+					fmt.Println("Erro ao carregar replay:", err)
+					return
+				}
+				m.stack.Push(NewReplayScene(rec))
+			},
 		)
 		cards = append(cards, card)
 	}
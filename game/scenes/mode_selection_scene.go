@@ -1,14 +1,17 @@
 package scenes
 
 import (
+	"fmt"
+
 	"github.com/allanjose001/go-battleship/game/components"
 	"github.com/allanjose001/go-battleship/game/components/basic"
 	"github.com/allanjose001/go-battleship/game/components/basic/colors"
 	"github.com/allanjose001/go-battleship/internal/entity"
+	"github.com/allanjose001/go-battleship/internal/service"
 	"github.com/hajimehoshi/ebiten/v2"
 )
 
-// ModeSelectionScene permite escolher entre Partida Clássica e Campanha.
+// ModeSelectionScene permite escolher entre Partida Clássica, Salvo, Campanha e Dinâmico.
 type ModeSelectionScene struct {
 	root components.LayoutWidget
 	StackHandler
@@ -35,6 +38,23 @@ func (m *ModeSelectionScene) OnEnter(prev Scene, size basic.Size) {
 			if m.ctx != nil {
 				m.ctx.IsDynamicMode = false
 				m.ctx.IsCampaign = false
+				m.ctx.IsSalvoMode = false
+			}
+			m.ctx.SoundService.PlaySFX("click", 0.8)
+			m.stack.Push(&DifficultyScene{})
+		},
+	)
+
+	// SALVO -> um tiro por navio vivo a cada turno, abre seleção de dificuldade
+	salvoBtn := components.NewButton(
+		basic.Point{}, btnSize,
+		"Salvo", colors.Dark,
+		nil,
+		func(b *components.Button) {
+			if m.ctx != nil {
+				m.ctx.IsDynamicMode = false
+				m.ctx.IsCampaign = false
+				m.ctx.IsSalvoMode = true
 			}
 			m.ctx.SoundService.PlaySFX("click", 0.8)
 			m.stack.Push(&DifficultyScene{})
@@ -46,6 +66,7 @@ func (m *ModeSelectionScene) OnEnter(prev Scene, size basic.Size) {
 		if m.ctx != nil {
 			m.ctx.IsDynamicMode = false
 			m.ctx.IsCampaign = true
+			m.ctx.IsSalvoMode = false
 			if m.profile != nil {
 				m.ctx.Profile = m.profile
 			}
@@ -60,6 +81,7 @@ func (m *ModeSelectionScene) OnEnter(prev Scene, size basic.Size) {
 			m.ctx.SetDifficulty("hard")
 			m.ctx.IsCampaign = false
 			m.ctx.IsDynamicMode = true
+			m.ctx.IsSalvoMode = false
 			if m.profile != nil {
 				m.ctx.Profile = m.profile
 			}
@@ -68,6 +90,52 @@ func (m *ModeSelectionScene) OnEnter(prev Scene, size basic.Size) {
 		m.stack.Push(NewPlacementSceneWithProfile(m.profile))
 	})
 
+	// liga/desliga a regra de navios sem contato (inclusive na diagonal); fica
+	// bloqueado quando a frota não cabe no tabuleiro com a margem entre os navios
+	optionSize := basic.Size{W: 300, H: 50}
+	noTouchBtn := components.NewButton(basic.Point{}, optionSize, "", colors.Blue, nil,
+		func(b *components.Button) {
+			if m.ctx != nil {
+				m.ctx.NoTouch = !m.ctx.NoTouch
+				m.refreshNoTouch(b)
+			}
+			m.ctx.SoundService.PlaySFX("click", 0.8)
+		})
+	m.refreshNoTouch(noTouchBtn)
+
+	// alterna a dimensão do tabuleiro entre as variantes suportadas (8x8 ... 15x15)
+	boardSizeBtn := components.NewButton(basic.Point{}, optionSize, boardSizeLabel(m.currentBoardSize()), colors.Blue, nil,
+		func(b *components.Button) {
+			next := nextBoardSize(m.currentBoardSize())
+			if m.ctx != nil {
+				m.ctx.SetBoardSize(next)
+			}
+			m.ctx.SoundService.PlaySFX("click", 0.8)
+			b.SetLabel(boardSizeLabel(next))
+			m.refreshNoTouch(noTouchBtn)
+		})
+
+	// alterna a composição da frota entre as embutidas e as carregadas de arquivo
+	fleetBtn := components.NewButton(basic.Point{}, optionSize, fleetSpecLabel(m.currentFleetSpec()), colors.Blue, nil,
+		func(b *components.Button) {
+			next := nextFleetSpec(m.currentFleetSpec())
+			if m.ctx != nil {
+				m.ctx.SetFleetSpec(next)
+			}
+			m.ctx.SoundService.PlaySFX("click", 0.8)
+			b.SetLabel(fleetSpecLabel(next))
+			m.refreshNoTouch(noTouchBtn)
+		})
+
+	options := components.NewRow(
+		basic.Point{},
+		20,
+		basic.Size{W: size.W, H: 50},
+		basic.Center,
+		basic.Center,
+		[]components.Widget{boardSizeBtn, fleetBtn, noTouchBtn},
+	)
+
 	backBtn := components.NewButton(basic.Point{}, basic.Size{W: 220, H: 50}, "Voltar", colors.Dark, nil,
 		func(b *components.Button) {
 			if m.ctx.CanPopOrPush {
@@ -81,7 +149,7 @@ func (m *ModeSelectionScene) OnEnter(prev Scene, size basic.Size) {
 		nil,
 	)
 	spacer2 := components.NewContainer(
-		basic.Point{}, basic.Size{W: 1, H: 100}, 0,
+		basic.Point{}, basic.Size{W: 1, H: 30}, 0,
 		colors.Transparent, basic.Center, basic.Center,
 		nil,
 	)
@@ -98,11 +166,14 @@ func (m *ModeSelectionScene) OnEnter(prev Scene, size basic.Size) {
 			components.NewText(basic.Point{}, "Selecione o Modo de Jogo", colors.White, 35),
 			spacer2,
 			classicBtn,
+			salvoBtn,
 			spacer,
 			campaignBtn,
 			spacer,
 			dynamicBtn,
-			spacer2,
+			spacer,
+			options,
+			spacer,
 			backBtn,
 		},
 	)
@@ -110,6 +181,80 @@ func (m *ModeSelectionScene) OnEnter(prev Scene, size basic.Size) {
 	_ = m.Update()
 }
 
+// currentBoardSize retorna a dimensão configurada no contexto (ou a padrão)
+func (m *ModeSelectionScene) currentBoardSize() int {
+	if m.ctx == nil {
+		return entity.DefaultBoardSize
+	}
+	return entity.NormalizeBoardSize(m.ctx.BoardSize)
+}
+
+// nextBoardSize retorna a próxima dimensão da lista, voltando ao início após a última
+func nextBoardSize(current int) int {
+	for i, size := range entity.BoardSizes {
+		if size == current {
+			return entity.BoardSizes[(i+1)%len(entity.BoardSizes)]
+		}
+	}
+	return entity.DefaultBoardSize
+}
+
+func boardSizeLabel(size int) string {
+	return fmt.Sprintf("Tabuleiro: %dx%d", size, size)
+}
+
+// currentFleetSpec retorna a composição configurada no contexto (ou a padrão)
+func (m *ModeSelectionScene) currentFleetSpec() entity.FleetSpec {
+	if m.ctx == nil || m.ctx.FleetSpec.ShipCount() == 0 {
+		return entity.DefaultFleetSpec
+	}
+	return m.ctx.FleetSpec
+}
+
+// nextFleetSpec retorna a próxima composição disponível, voltando ao início após a última
+func nextFleetSpec(current entity.FleetSpec) entity.FleetSpec {
+	specs := service.AvailableFleetSpecs()
+	for i, spec := range specs {
+		if spec.ID == current.ID {
+			return specs[(i+1)%len(specs)]
+		}
+	}
+	return entity.DefaultFleetSpec
+}
+
+func fleetSpecLabel(spec entity.FleetSpec) string {
+	return fmt.Sprintf("Frota: %s", spec.Name)
+}
+
+// noTouchAvailable diz se a regra de navios sem contato vale com o tabuleiro e a
+// frota escolhidos (a mesma resolução da PlacementScene)
+func (m *ModeSelectionScene) noTouchAvailable() bool {
+	size, spec := m.currentBoardSize(), m.currentFleetSpec()
+	if spec.Validate(size) != nil {
+		spec = entity.DefaultFleetSpec
+	}
+	return service.ResolveNoTouch(true, spec, size)
+}
+
+// refreshNoTouch atualiza o botão da regra sem contato: a escolha fica guardada
+// no contexto, mas o botão é bloqueado enquanto a frota não couber com a margem
+func (m *ModeSelectionScene) refreshNoTouch(b *components.Button) {
+	available := m.noTouchAvailable()
+	b.SetLabel(noTouchLabel(m.ctx != nil && m.ctx.NoTouch, available))
+	b.SetDisabled(!available)
+}
+
+func noTouchLabel(noTouch, available bool) string {
+	switch {
+	case !available:
+		return "Sem contato: indisponível"
+	case noTouch:
+		return "Navios: sem contato"
+	default:
+		return "Navios: podem encostar"
+	}
+}
+
 func (m *ModeSelectionScene) OnExit(next Scene) {
 	m.stack.ctx.CanPopOrPush = false
 }
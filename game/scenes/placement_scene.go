@@ -3,14 +3,17 @@ package scenes
 import (
 	"fmt"
 	"image/color"
-	"sort"
+	"math/rand"
 	"time"
 
 	"github.com/allanjose001/go-battleship/game/components"
 	"github.com/allanjose001/go-battleship/game/components/basic"
 	"github.com/allanjose001/go-battleship/game/components/basic/colors"
+	"github.com/allanjose001/go-battleship/game/shared/assets"
 	"github.com/allanjose001/go-battleship/game/shared/board"
 	"github.com/allanjose001/go-battleship/game/shared/placement"
+	"github.com/allanjose001/go-battleship/game/state"
+	"github.com/allanjose001/go-battleship/internal/ai"
 	"github.com/allanjose001/go-battleship/internal/entity"
 	"github.com/allanjose001/go-battleship/internal/service"
 	"github.com/hajimehoshi/ebiten/v2"
@@ -39,10 +42,12 @@ func (r *placementRenderer) Draw(b *board.Board, ships []*placement.ShipPlacemen
 // de placement e os componentes de interface.
 type PlacementScene struct {
 	// svc encapsula toda a regra de negócio de posicionamento
-	svc service.PlacementService
+	svc placement.PlacementService
 	// board e ships são usados para construir o GameState de batalha
 	board *board.Board
 	ships []*placement.ShipPlacement
+	// fleetSpec é a composição de frota da partida (ships segue a ordem de fleetSpec.Sizes)
+	fleetSpec entity.FleetSpec
 	// perfil do jogador selecionado na tela anterior
 	playerProfile *entity.Profile
 	// container com a linha de botões sob o tabuleiro (Aleatório, Rotacionar)
@@ -88,36 +93,56 @@ func (s *PlacementScene) SetSeriesState(index, pWins, eWins int) {
 // Aqui criamos o tabuleiro, carregamos imagens, configuramos navios,
 // serviços e os botões de interface.
 func (s *PlacementScene) OnEnter(prev Scene, size basic.Size) {
-	// Cria o tabuleiro do jogador na tela
+	// Cria o tabuleiro do jogador na tela, com a dimensão escolhida para a partida
 	s.decorations = []components.Widget{}
-	b := board.NewBoard(80, 100, 400)
+	boardSize := entity.DefaultBoardSize
+	if s.stack.ctx != nil {
+		boardSize = entity.NormalizeBoardSize(s.stack.ctx.BoardSize)
+	}
+	b := board.NewBoard(80, 100, 400, boardSize, boardSize)
 
 	// Tenta carregar a imagem de fundo do tabuleiro
-	bg, _, err := ebitenutil.NewImageFromFile("assets/images/Mask group.png")
-	if err == nil {
+	if bg := assets.OptionalImage("assets/images/Mask group.png"); bg != nil {
 		b.BackgroundImage = bg
 	}
 
 	// Carrega os sprites dos navios com tamanhos diferentes
-	img1, _, _ := ebitenutil.NewImageFromFile("assets/images/1 slot 1.png")
-	img2, _, _ := ebitenutil.NewImageFromFile("assets/images/3 slots 2.png")
-	img3, _, _ := ebitenutil.NewImageFromFile("assets/images/Frame 400.png")
-	img4, _, _ := ebitenutil.NewImageFromFile("assets/images/NAVIO 4 SLOTS 1.png")
-
-	battleAssets := LoadBattleAssets()
-
-	ships := []*placement.ShipPlacement{
-		{Image: img3, SunkImage: battleAssets.SunkShip3, Size: 6, ListX: 800, ListY: 100},
-		{Image: img3, SunkImage: battleAssets.SunkShip3, Size: 6, ListX: 800, ListY: 160},
-		{Image: img4, SunkImage: battleAssets.SunkShip4, Size: 4, ListX: 800, ListY: 250},
-		{Image: img4, SunkImage: battleAssets.SunkShip4, Size: 4, ListX: 800, ListY: 320},
-		{Image: img2, SunkImage: battleAssets.SunkShip2, Size: 3, ListX: 800, ListY: 380},
-		{Image: img1, SunkImage: battleAssets.SunkShip1, Size: 1, ListX: 800, ListY: 440},
+	sprites := loadShipSprites()
+
+	// A composição da frota vem do contexto; se não couber no tabuleiro, usa a padrão
+	spec := entity.DefaultFleetSpec
+	if s.stack.ctx != nil {
+		spec = s.stack.ctx.FleetSpec
+	}
+	s.fleetSpec = service.ResolveFleetSpec(spec, boardSize)
+	if s.stack.ctx != nil {
+		b.NoTouch = service.ResolveNoTouch(s.stack.ctx.NoTouch, s.fleetSpec, boardSize)
+	}
+
+	// Monta a lista lateral na ordem da composição, empilhando pela altura de cada sprite
+	var ships []*placement.ShipPlacement
+	listY := 100.0
+	for _, size := range s.fleetSpec.Sizes() {
+		sp := closestShipSprite(sprites, size)
+		ships = append(ships, &placement.ShipPlacement{
+			Image: sp.image, SunkImage: sp.sunk, Size: size, ListX: 800, ListY: listY,
+		})
+
+		h := 50
+		if sp.image != nil {
+			h = sp.image.Bounds().Dy()
+		}
+		listY += float64(h) + 15
 	}
 
 	s.board = b
 	s.ships = ships
-	s.svc = service.NewPlacementService(b, ships)
+	// com a seed fixa (BATTLESHIP_SEED) o posicionamento aleatório do jogador também se repete
+	var placementRng *rand.Rand
+	if s.stack.ctx != nil && s.stack.ctx.Seed != 0 {
+		placementRng = service.NewPlacementRand(s.stack.ctx.Seed)
+	}
+	s.svc = placement.NewPlacementService(b, ships, placementRng)
 
 	btnColor := color.RGBA{48, 67, 103, 255}
 	playBtnColor := color.RGBA{60, 120, 60, 255}
@@ -144,32 +169,6 @@ func (s *PlacementScene) OnEnter(prev Scene, size basic.Size) {
 				return
 			}
 
-			factory := service.NewGameService()
-			gs, aiShips := factory.NewBattleGameState(s.board, s.ships)
-
-			// Ordena navios da IA para garantir consistência com a lógica de batalha
-			sort.Slice(aiShips, func(i, j int) bool {
-				return aiShips[i].Size > aiShips[j].Size
-			})
-
-			// ✅ Atribui texturas aos navios da IA
-			for _, ship := range aiShips {
-				switch ship.Size {
-				case 6:
-					ship.Image = img3
-					ship.SunkImage = battleAssets.SunkShip3
-				case 4:
-					ship.Image = img4
-					ship.SunkImage = battleAssets.SunkShip4
-				case 3:
-					ship.Image = img2
-					ship.SunkImage = battleAssets.SunkShip2
-				case 1:
-					ship.Image = img1
-					ship.SunkImage = battleAssets.SunkShip1
-				}
-			}
-
 			matchID := fmt.Sprintf("match-%d", time.Now().UnixNano())
 
 			diff := "easy"
@@ -178,17 +177,45 @@ func (s *PlacementScene) OnEnter(prev Scene, size basic.Size) {
 			}
 
 			isDynamic := s.stack.ctx != nil && s.stack.ctx.IsDynamicMode
-			match := entity.NewMatch(matchID, diff, gs.PlayerBoard, gs.AIBoard, s.ships, aiShips, s.playerProfile, isDynamic)
+			match := entity.NewMatch(matchID, diff, s.board.Rows, s.playerProfile, isDynamic)
+			match.FleetSpec = s.fleetSpec
+			match.IsSalvoMode = !isDynamic && s.stack.ctx != nil && s.stack.ctx.IsSalvoMode
+			match.NoTouch = s.board.NoTouch
+			if s.stack.ctx != nil && s.stack.ctx.Seed != 0 {
+				match.SetSeed(s.stack.ctx.Seed)
+			}
+
+			factory := state.NewGameService()
+			// os dois lados saem com board/frota lógicos prontos (gs.AIShips segue a ordem de
+			// gs.AIFleet); a frota da IA segue a política de posicionamento do perfil de IA e
+			// usa o gerador da partida, reproduzível pela seed
+			aiProfile := diff
+			if isDynamic {
+				aiProfile = ai.DynamicProfile.ID
+			}
+			aiPlacement := service.NewAIPlacement(aiProfile, s.playerProfile, s.board.Rows)
+			gs := factory.NewBattleGameState(s.board, s.ships, s.fleetSpec, aiPlacement, match.Rand())
+
+			// ✅ Atribui texturas aos navios da IA
+			for _, ship := range gs.AIShips {
+				sp := closestShipSprite(sprites, ship.Size)
+				ship.Image = sp.image
+				ship.SunkImage = sp.sunk
+			}
+
+			match.PlayerEntityBoard, match.PlayerFleet = gs.PlayerEntityBoard, gs.PlayerFleet
+			match.EnemyEntityBoard, match.EnemyFleet = gs.AIEntityBoard, gs.AIFleet
 
 			if s.stack.ctx != nil {
 				s.stack.ctx.Match = match
+				s.stack.ctx.SetBattle(gs)
 				s.stack.ctx.BattleService = nil // limpa para forçar recriação correta
 			}
 
 			// ✅ CORREÇÃO: Para modo dinâmico, NÃO cria BattleService aqui
 			// A DynamicBattleScene cria o próprio serviço
 			if !isDynamic {
-				svc, err := service.NewBattleServiceFromMatch(match, s.ctx != nil && s.ctx.IsCampaign, s.ctx.SoundService)
+				svc, err := service.NewBattleServiceFromMatch(match, s.ctx != nil && s.ctx.IsCampaign, s.ctx.SoundService, s.ctx.Profiles)
 				if err != nil {
 					return
 				}
@@ -311,6 +338,8 @@ func (s *PlacementScene) OnEnter(prev Scene, size basic.Size) {
 				aiName = "Imediato Bot"
 			case "hard":
 				aiName = "Almirante Bot"
+			case "expert":
+				aiName = "Lenda Bot"
 			}
 		}
 
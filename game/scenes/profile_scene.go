@@ -1,11 +1,16 @@
 package scenes
 
 import (
+	"fmt"
+	"time"
+
 	"github.com/allanjose001/go-battleship/game/components"
 	"github.com/allanjose001/go-battleship/game/components/basic"
 	"github.com/allanjose001/go-battleship/game/components/basic/colors"
+	"github.com/allanjose001/go-battleship/game/shared/placement"
 	"github.com/allanjose001/go-battleship/game/state"
 	"github.com/allanjose001/go-battleship/internal/medal"
+	"github.com/allanjose001/go-battleship/internal/service"
 	"github.com/hajimehoshi/ebiten/v2"
 )
 
@@ -102,16 +107,13 @@ func (p *ProfileScene) init(size basic.Size) {
 				nil,
 			),
 
-			// Botão para acessar o histórico de partidas
-			components.NewButton(
+			// Botões de partida salva e histórico
+			components.NewRow(
 				basic.Point{},
-				basic.Size{W: 300, H: 55},
-				"Histórico de Partidas",
-				colors.Dark,
-				colors.White,
-				func(b *components.Button) {
-					p.stack.Push(&MatchsHistory{})
-				},
+				20,
+				basic.Size{W: size.W, H: 55},
+				basic.Center, basic.Center,
+				p.matchButtons(),
 			),
 
 			// Botão Voltar
@@ -130,6 +132,93 @@ func (p *ProfileScene) init(size basic.Size) {
 	_ = p.Update()
 }
 
+// matchButtons monta o botão de histórico, o de continuar a partida em
+// andamento salva (se houver) e o de apagar os hábitos aprendidos pela IA.
+func (p *ProfileScene) matchButtons() []components.Widget {
+	var buttons []components.Widget
+
+	if service.HasMatchInProgress(p.stack.ctx.Profile.Username) {
+		buttons = append(buttons, components.NewButton(
+			basic.Point{},
+			basic.Size{W: 300, H: 55},
+			"Continuar Partida",
+			colors.Dark,
+			colors.White,
+			func(b *components.Button) {
+				p.resumeMatch()
+			},
+		))
+	}
+
+	// Botão para acessar o histórico de partidas
+	buttons = append(buttons, components.NewButton(
+		basic.Point{},
+		basic.Size{W: 300, H: 55},
+		"Histórico de Partidas",
+		colors.Dark,
+		colors.White,
+		func(b *components.Button) {
+			p.stack.Push(&MatchsHistory{})
+		},
+	))
+
+	// Botão para a IA esquecer onde o jogador costuma posicionar os navios
+	if !p.stack.ctx.Profile.PlacementHabits.Empty() {
+		buttons = append(buttons, components.NewButton(
+			basic.Point{},
+			basic.Size{W: 300, H: 55},
+			"Esquecer Hábitos",
+			colors.Dark,
+			colors.White,
+			func(b *components.Button) {
+				if err := p.stack.ctx.Profiles.ResetPlacementHabits(p.stack.ctx.Profile); err != nil {
+					fmt.Println("Erro ao apagar hábitos:", err)
+					return
+				}
+				p.stack.ctx.SoundService.PlaySFX("click", 0.8)
+				b.SetLabel("Hábitos Esquecidos")
+			},
+		))
+	}
+	return buttons
+}
+
+// resumeMatch restaura a partida salva do perfil e vai direto para a batalha.
+func (p *ProfileScene) resumeMatch() {
+	ctx := p.stack.ctx
+
+	match, svc, err := service.ResumeBattleService(ctx.Profile, ctx.SoundService, ctx.Profiles, time.Now())
+	if err != nil {
+		fmt.Println("Erro ao continuar partida:", err)
+		return
+	}
+
+	gs := state.NewGameService().NewResumedGameState(match)
+	sprites := loadShipSprites()
+	for _, ships := range [][]*placement.ShipPlacement{gs.PlayerShips, gs.AIShips} {
+		for _, ship := range ships {
+			sp := closestShipSprite(sprites, ship.Size)
+			ship.Image = sp.image
+			ship.SunkImage = sp.sunk
+		}
+	}
+
+	ctx.SoundService.PlaySFX("click", 0.8)
+	ctx.SetMatch(match)
+	ctx.SetBattle(gs)
+	ctx.SetBattleService(svc)
+	ctx.SetDifficulty(match.Difficulty)
+	ctx.IsCampaign = false
+	ctx.IsDynamicMode = match.IsDynamicMode
+	ctx.IsSalvoMode = match.IsSalvoMode
+
+	if match.IsDynamicMode {
+		SwitchTo(NewDynamicBattleScene())
+	} else {
+		SwitchTo(NewBattleScene())
+	}
+}
+
 // loadMedals agora é um método de ProfileScene para acessar p.stack.ctx.Profile.Stats
 func (p *ProfileScene) loadMedals() *[]components.Widget {
 	var widgets = []components.Widget{}
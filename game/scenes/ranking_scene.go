@@ -4,7 +4,6 @@ import (
 	"github.com/allanjose001/go-battleship/game/components"
 	"github.com/allanjose001/go-battleship/game/components/basic"
 	"github.com/allanjose001/go-battleship/game/components/basic/colors"
-	"github.com/allanjose001/go-battleship/internal/service"
 	"github.com/hajimehoshi/ebiten/v2"
 )
 
@@ -51,7 +50,7 @@ func (m *RankingScene) init(screenSize basic.Size) {
 	start := m.currentPage * itemsPerPage
 	end := start + itemsPerPage
 
-	allPlayers := service.GetTopScores(9)
+	allPlayers := m.ctx.Profiles.TopScores(9)
 
 	if start > len(allPlayers) {
 		start = len(allPlayers)
@@ -0,0 +1,249 @@
+package scenes
+
+import (
+	"fmt"
+
+	"github.com/allanjose001/go-battleship/game/components"
+	"github.com/allanjose001/go-battleship/game/components/basic"
+	"github.com/allanjose001/go-battleship/game/components/basic/colors"
+	"github.com/allanjose001/go-battleship/game/shared/assets"
+	"github.com/allanjose001/go-battleship/game/shared/board"
+	"github.com/allanjose001/go-battleship/game/shared/placement"
+	"github.com/allanjose001/go-battleship/internal/entity"
+	"github.com/allanjose001/go-battleship/internal/service"
+	"github.com/hajimehoshi/ebiten/v2"
+)
+
+// replayFramesPerEvent é quantos frames (60 TPS) cada evento fica na tela em 1x.
+const replayFramesPerEvent = 45
+
+// replaySpeeds são as velocidades disponíveis no botão "Velocidade".
+var replaySpeeds = []int{1, 2, 4}
+
+// ReplayScene reproduz uma partida salva (entity.MatchRecord) com os dois
+// tabuleiros revelados, aplicando os eventos pelo service.Replayer.
+type ReplayScene struct {
+	record   *entity.MatchRecord
+	replayer *service.Replayer
+
+	playerBoard, enemyBoard *board.Board
+	playerShips, enemyShips []*placement.ShipPlacement
+
+	assets    *BattleAssets
+	boardView *components.BattleBoardView
+	divider   *components.VerticalDivider
+
+	controls   components.StylableWidget
+	statusText *components.Text
+	eventText  *components.Text
+
+	playing  bool
+	speedIdx int
+	frames   int
+
+	StackHandler
+}
+
+// NewReplayScene cria a cena de replay para o log informado.
+func NewReplayScene(rec *entity.MatchRecord) *ReplayScene {
+	return &ReplayScene{record: rec}
+}
+
+func (s *ReplayScene) GetMusic() string {
+	return "menus"
+}
+
+func (s *ReplayScene) OnEnter(_ Scene, screenSize basic.Size) {
+	replayer, err := service.NewReplayer(s.record)
+	if err != nil {
+		fmt.Println("Erro ao abrir replay:", err)
+	}
+	s.replayer = replayer
+
+	if s.assets == nil {
+		s.assets = LoadBattleAssets()
+	}
+	s.boardView = components.NewBattleBoardView(s.assets.FireAnimation, s.assets.HitImage, s.assets.MissImage)
+
+	if replayer != nil {
+		s.initBoards()
+	}
+
+	s.statusText = components.NewText(basic.Point{X: 80, Y: 40}, "", colors.White, 24)
+	s.eventText = components.NewText(basic.Point{X: 80, Y: 75}, "", colors.White, 18)
+	s.initControls(screenSize)
+
+	s.playing = true
+	_ = s.Update()
+	s.stack.ctx.CanPopOrPush = true
+}
+
+func (s *ReplayScene) OnExit(_ Scene) {
+	s.stack.ctx.CanPopOrPush = false
+}
+
+// initBoards monta os tabuleiros visuais na mesma geometria da batalha
+// e um sprite por navio de cada frota (mesma ordem de fleet.Ships).
+func (s *ReplayScene) initBoards() {
+	size := s.record.BoardSize
+
+	s.playerBoard = board.NewBoard(80, 100, 400, size, size)
+	s.enemyBoard = board.NewBoard(1280-80-400, 100, 400, size, size)
+
+	if bg := assets.OptionalImage("assets/images/Mask group.png"); bg != nil {
+		s.playerBoard.BackgroundImage = bg
+		s.enemyBoard.BackgroundImage = bg
+	}
+
+	s.divider = components.NewVerticalDivider(640, s.playerBoard.Y, s.playerBoard.Y+s.playerBoard.Size, colors.White)
+
+	sprites := loadShipSprites()
+	s.playerShips = replayShipSprites(sprites, s.replayer.PlayerFleet)
+	s.enemyShips = replayShipSprites(sprites, s.replayer.EnemyFleet)
+}
+
+func replayShipSprites(sprites map[int]shipSprite, fleet *entity.Fleet) []*placement.ShipPlacement {
+	ships := make([]*placement.ShipPlacement, 0, len(fleet.Ships))
+	for _, ship := range fleet.Ships {
+		sp := closestShipSprite(sprites, ship.Size)
+		ships = append(ships, &placement.ShipPlacement{Image: sp.image, SunkImage: sp.sunk, Size: ship.Size})
+	}
+	return ships
+}
+
+func (s *ReplayScene) initControls(screenSize basic.Size) {
+	btSize := basic.Size{W: 180, H: 50}
+
+	row := components.NewRow(
+		basic.Point{},
+		20,
+		basic.Size{W: screenSize.W, H: 50},
+		basic.Center,
+		basic.Center,
+		[]components.Widget{
+			components.NewButton(basic.Point{}, btSize, "Play/Pausa", colors.Dark, nil, func(bt *components.Button) {
+				if s.replayer != nil && s.replayer.Done() {
+					// recomeça do início ao dar play no fim do replay
+					_ = s.replayer.Seek(0)
+				}
+				s.playing = !s.playing
+				s.frames = 0
+			}),
+			components.NewButton(basic.Point{}, btSize, "Passo", colors.Dark, nil, func(bt *components.Button) {
+				s.playing = false
+				if s.replayer != nil {
+					s.replayer.Step()
+				}
+			}),
+			components.NewButton(basic.Point{}, btSize, "Velocidade", colors.Dark, nil, func(bt *components.Button) {
+				s.speedIdx = (s.speedIdx + 1) % len(replaySpeeds)
+			}),
+			components.NewButton(basic.Point{}, btSize, "Voltar", colors.Red, nil, func(bt *components.Button) {
+				s.stack.Pop()
+			}),
+		},
+	)
+
+	s.controls = components.NewContainer(
+		basic.Point{X: 0, Y: 620},
+		basic.Size{W: screenSize.W, H: 50},
+		0,
+		colors.Transparent,
+		basic.Center,
+		basic.Center,
+		row,
+	)
+}
+
+func (s *ReplayScene) Update() error {
+	if s.controls != nil {
+		s.controls.Update(basic.Point{})
+	}
+	if s.replayer == nil {
+		if s.statusText != nil {
+			s.statusText.Text = "Replay indisponível"
+			s.statusText.Update(basic.Point{})
+		}
+		return nil
+	}
+
+	if s.playing {
+		s.frames += replaySpeeds[s.speedIdx]
+		if s.frames >= replayFramesPerEvent {
+			s.frames = 0
+			if !s.replayer.Step() {
+				s.playing = false
+			}
+		}
+	}
+
+	s.updateLabels()
+	return nil
+}
+
+func (s *ReplayScene) updateLabels() {
+	state := "Pausado"
+	if s.playing {
+		state = "Reproduzindo"
+	}
+	s.statusText.Text = fmt.Sprintf("Replay  %03d/%03d  |  %dx  |  %s",
+		s.replayer.Pos(), s.replayer.Len(), replaySpeeds[s.speedIdx], state)
+	s.statusText.Update(basic.Point{})
+
+	s.eventText.Text = describeReplayEvent(s.replayer)
+	s.eventText.Update(basic.Point{})
+}
+
+// describeReplayEvent descreve o último evento aplicado para a legenda do replay.
+func describeReplayEvent(r *service.Replayer) string {
+	ev, ok := r.Last()
+	if !ok {
+		return "Início da partida"
+	}
+
+	who := "Jogador"
+	if ev.By == entity.TurnEnemy {
+		who = "IA"
+	}
+
+	switch ev.Kind {
+	case entity.RecordMove:
+		return fmt.Sprintf("%s moveu o navio %d para %c%d", who, ev.Ship+1, 'A'+rune(ev.Col), ev.Row+1)
+	case entity.RecordAttack:
+		outcome := "água"
+		if ev.Sunk {
+			outcome = "afundou um navio"
+		} else if ev.Hit {
+			outcome = "acertou"
+		}
+		return fmt.Sprintf("%s atirou em %c%d: %s", who, 'A'+rune(ev.Col), ev.Row+1, outcome)
+	}
+	return ""
+}
+
+func (s *ReplayScene) Draw(screen *ebiten.Image) {
+	if s.replayer != nil && s.playerBoard != nil {
+		s.playerBoard.Draw(screen)
+		s.enemyBoard.Draw(screen)
+
+		if s.divider != nil {
+			s.divider.Draw(screen)
+		}
+
+		s.boardView.DrawBoard(screen, s.playerBoard, s.playerShips, s.replayer.PlayerFleet, s.replayer.PlayerBoard, false)
+		s.boardView.DrawBoard(screen, s.enemyBoard, s.enemyShips, s.replayer.EnemyFleet, s.replayer.EnemyBoard, false)
+	}
+
+	if s.statusText != nil {
+		s.statusText.Draw(screen)
+	}
+	if s.eventText != nil {
+		s.eventText.Draw(screen)
+	}
+	if s.controls != nil {
+		s.controls.Draw(screen)
+	}
+}
+
+// Verifica em tempo de compilação se ReplayScene implementa Scene.
+var _ Scene = (*ReplayScene)(nil)
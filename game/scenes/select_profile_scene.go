@@ -5,7 +5,6 @@ import (
 	"github.com/allanjose001/go-battleship/game/components/basic"
 	"github.com/allanjose001/go-battleship/game/components/basic/colors"
 	"github.com/allanjose001/go-battleship/internal/entity"
-	"github.com/allanjose001/go-battleship/internal/service"
 	"github.com/hajimehoshi/ebiten/v2"
 )
 
@@ -22,7 +21,7 @@ func (s *SelectProfileScene) GetMusic() string {
 }
 
 func (s *SelectProfileScene) OnEnter(prev Scene, size basic.Size) {
-	s.profiles = service.GetProfiles()
+	s.profiles = s.ctx.Profiles.Profiles()
 	s.screenSize = size
 	s.buildUI(size)
 	s.stack.ctx.CanPopOrPush = true
@@ -171,8 +170,8 @@ func (s *SelectProfileScene) createProfileRow(p *entity.Profile, width float32,
 
 	// Ícone de deletar
 	deleteBtn := components.NewDeleteIconButton(basic.Point{}, iconSize, func() {
-		_ = service.RemoveProfile(profile.Username)
-		s.profiles = service.GetProfiles()
+		_ = s.ctx.Profiles.Remove(profile.Username)
+		s.profiles = s.ctx.Profiles.Profiles()
 		s.ctx.SoundService.PlaySFX("click", 0.8)
 		s.buildUI(s.screenSize)
 	})
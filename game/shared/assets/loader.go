@@ -0,0 +1,60 @@
+// Asset Loader: utilitários de carregamento de imagens e animações
+// para a fase de batalha. Mantém o acesso aos arquivos centralizado
+// (ver Manager, que lê os assets embutidos no binário) e converte
+// formatos (como GIF) em ebiten.Image utilizável.
+package assets
+
+import (
+	"github.com/hajimehoshi/ebiten/v2"
+)
+
+const (
+	firePath = "assets/images/Fire.gif"
+	missPath = "assets/images/Ponto que já foi atingido 1.png"
+
+	sunkShip1Path = "assets/images/1 slot 1 afund.png"
+	sunkShip2Path = "assets/images/3 slot 2 afund.png"
+	sunkShip3Path = "assets/images/Frame 400 afund.png"
+	sunkShip4Path = "assets/images/NAVIO 4 SLOTS 1 afund.png"
+)
+
+// LoadFireAnimation:
+// - Carrega o GIF pelo gerenciador (decodificado uma vez só)
+// - Devolve os frames já como ebiten.Image e seus delays
+func LoadFireAnimation() ([]*ebiten.Image, []int, error) {
+	anim, err := LoadAnimation(firePath)
+	if err != nil {
+		return nil, nil, err
+	}
+	return anim.Frames, anim.Delays, nil
+}
+
+// LoadHitImage:
+// - Carrega uma imagem usada como efeito de acerto
+// - Caso a animação esteja indisponível, ela pode servir como fallback
+func LoadHitImage() (*ebiten.Image, error) {
+	return Image(firePath)
+}
+
+// LoadMissImage:
+// - Carrega a imagem para marcar erros (miss) nos tiros
+// - Usada no renderer para desenhar marcadores de jogadas
+func LoadMissImage() (*ebiten.Image, error) {
+	return Image(missPath)
+}
+
+func LoadSunkShip1() (*ebiten.Image, error) {
+	return Image(sunkShip1Path)
+}
+
+func LoadSunkShip2() (*ebiten.Image, error) {
+	return Image(sunkShip2Path)
+}
+
+func LoadSunkShip3() (*ebiten.Image, error) {
+	return Image(sunkShip3Path)
+}
+
+func LoadSunkShip4() (*ebiten.Image, error) {
+	return Image(sunkShip4Path)
+}
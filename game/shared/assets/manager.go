@@ -0,0 +1,179 @@
+package assets
+
+import (
+	"bytes"
+	"fmt"
+	"image"
+	"image/gif"
+	_ "image/png" // decodificador dos PNG de assets/
+	"io/fs"
+	"sync"
+
+	battleship "github.com/allanjose001/go-battleship"
+	"github.com/hajimehoshi/ebiten/v2"
+	"golang.org/x/image/font/opentype"
+)
+
+// Animation é um GIF decodificado: os quadros e o delay de cada um (em
+// centésimos de segundo, como no GIF).
+type Animation struct {
+	Frames []*ebiten.Image
+	Delays []int
+}
+
+// Manager é o ponto único de acesso aos assets do jogo. Lê de um fs.FS (os
+// assets embutidos no binário, por padrão), decodifica cada arquivo uma vez e
+// guarda o resultado em cache por caminho. Os caminhos são os do repositório
+// (ex.: "assets/images/mouse.png").
+type Manager struct {
+	fsys fs.FS
+
+	mu         sync.Mutex
+	images     map[string]*ebiten.Image
+	animations map[string]*Animation
+	fonts      map[string]*opentype.Font
+	reported   map[string]bool // assets opcionais ausentes já avisados no console
+}
+
+// NewManager cria um gerenciador que lê os assets de fsys.
+func NewManager(fsys fs.FS) *Manager {
+	return &Manager{
+		fsys:       fsys,
+		images:     make(map[string]*ebiten.Image),
+		animations: make(map[string]*Animation),
+		fonts:      make(map[string]*opentype.Font),
+		reported:   make(map[string]bool),
+	}
+}
+
+// defaultManager lê os assets embutidos no binário (battleship.Assets).
+var defaultManager = NewManager(battleship.Assets)
+
+// Bytes devolve o conteúdo bruto do asset em path (áudio, JSON). Não há cache:
+// os assets embutidos já estão em memória.
+func (m *Manager) Bytes(path string) ([]byte, error) {
+	return fs.ReadFile(m.fsys, path)
+}
+
+// Decode decodifica a imagem em path sem convertê-la para a GPU (ex.: ícone
+// da janela).
+func (m *Manager) Decode(path string) (image.Image, error) {
+	data, err := m.Bytes(path)
+	if err != nil {
+		return nil, err
+	}
+	img, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return img, nil
+}
+
+// Image devolve a imagem em path pronta para desenhar. A mesma *ebiten.Image é
+// compartilhada por todos que pedirem o mesmo caminho: não desenhe sobre ela.
+func (m *Manager) Image(path string) (*ebiten.Image, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if img, ok := m.images[path]; ok {
+		return img, nil
+	}
+	decoded, err := m.Decode(path)
+	if err != nil {
+		return nil, err
+	}
+	img := ebiten.NewImageFromImage(decoded)
+	m.images[path] = img
+	return img, nil
+}
+
+// OptionalImage é Image para imagens sem as quais a tela ainda funciona
+// (sprites, fundos): se a imagem faltar, avisa uma vez no console e devolve nil.
+func (m *Manager) OptionalImage(path string) *ebiten.Image {
+	img, err := m.Image(path)
+	if err != nil {
+		m.reportMissing(path, err)
+		return nil
+	}
+	return img
+}
+
+// Animation devolve os quadros do GIF em path.
+func (m *Manager) Animation(path string) (*Animation, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if anim, ok := m.animations[path]; ok {
+		return anim, nil
+	}
+	data, err := m.Bytes(path)
+	if err != nil {
+		return nil, err
+	}
+	g, err := gif.DecodeAll(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+
+	anim := &Animation{
+		Frames: make([]*ebiten.Image, len(g.Image)),
+		Delays: make([]int, len(g.Delay)),
+	}
+	for i, img := range g.Image {
+		anim.Frames[i] = ebiten.NewImageFromImage(img)
+		anim.Delays[i] = g.Delay[i]
+	}
+	m.animations[path] = anim
+	return anim, nil
+}
+
+// Font devolve a fonte TrueType/OpenType em path.
+func (m *Manager) Font(path string) (*opentype.Font, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if f, ok := m.fonts[path]; ok {
+		return f, nil
+	}
+	data, err := m.Bytes(path)
+	if err != nil {
+		return nil, err
+	}
+	f, err := opentype.Parse(data)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	m.fonts[path] = f
+	return f, nil
+}
+
+// reportMissing avisa no console, uma vez por caminho, que um asset opcional
+// não pôde ser carregado.
+func (m *Manager) reportMissing(path string, err error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.reported[path] {
+		return
+	}
+	m.reported[path] = true
+	fmt.Println("Asset indisponível (seguindo sem ele):", err)
+}
+
+// Bytes lê um asset bruto pelo gerenciador padrão (ver Manager.Bytes).
+func Bytes(path string) ([]byte, error) { return defaultManager.Bytes(path) }
+
+// Decode decodifica uma imagem pelo gerenciador padrão (ver Manager.Decode).
+func Decode(path string) (image.Image, error) { return defaultManager.Decode(path) }
+
+// Image carrega uma imagem pelo gerenciador padrão (ver Manager.Image).
+func Image(path string) (*ebiten.Image, error) { return defaultManager.Image(path) }
+
+// OptionalImage carrega uma imagem opcional pelo gerenciador padrão (ver Manager.OptionalImage).
+func OptionalImage(path string) *ebiten.Image { return defaultManager.OptionalImage(path) }
+
+// LoadAnimation carrega um GIF pelo gerenciador padrão (ver Manager.Animation).
+func LoadAnimation(path string) (*Animation, error) { return defaultManager.Animation(path) }
+
+// Font carrega uma fonte pelo gerenciador padrão (ver Manager.Font).
+func Font(path string) (*opentype.Font, error) { return defaultManager.Font(path) }
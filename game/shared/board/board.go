@@ -4,24 +4,37 @@ import "github.com/hajimehoshi/ebiten/v2"
 
 //estrutura do tabuleiro
 
+// dimensões padrão, usadas quando a partida não define outra
 const (
-	Rows = 10
-	Cols = 10
+	DefaultRows = 10
+	DefaultCols = 10
 )
 
 type Board struct {
 	Cells           [][]Cell
+	Rows            int // número de linhas/colunas da partida
+	Cols            int
 	X               float64 // posição na tela
 	Y               float64
 	Size            float64 // tamanho total
 	BackgroundImage *ebiten.Image
+	NoTouch         bool // regra opcional: navios não podem se encostar, nem na diagonal
 }
 
-func NewBoard(x, y, size float64) *Board {
-	cells := make([][]Cell, Rows)
-	for i := 0; i < Rows; i++ {
-		cells[i] = make([]Cell, Cols)
-		for j := 0; j < Cols; j++ {
+// NewBoard cria um tabuleiro rows x cols ocupando size pixels na tela.
+// rows/cols <= 0 usam as dimensões padrão.
+func NewBoard(x, y, size float64, rows, cols int) *Board {
+	if rows <= 0 {
+		rows = DefaultRows
+	}
+	if cols <= 0 {
+		cols = DefaultCols
+	}
+
+	cells := make([][]Cell, rows)
+	for i := 0; i < rows; i++ {
+		cells[i] = make([]Cell, cols)
+		for j := 0; j < cols; j++ {
 			cells[i][j] = Cell{
 				Row:   i,
 				Col:   j,
@@ -32,12 +45,19 @@ func NewBoard(x, y, size float64) *Board {
 
 	return &Board{
 		Cells: cells,
+		Rows:  rows,
+		Cols:  cols,
 		X:     x,
 		Y:     y,
 		Size:  size,
 	}
 }
 
+// CellSize retorna o tamanho em pixels de cada célula.
+func (b *Board) CellSize() float64 {
+	return b.Size / float64(b.Cols)
+}
+
 type Orientation int
 
 const (
@@ -46,12 +66,12 @@ const (
 )
 
 func (b *Board) CanPlace(size, row, col int, orientation Orientation) bool {
-	if row < 0 || row >= Rows || col < 0 || col >= Cols {
+	if row < 0 || row >= b.Rows || col < 0 || col >= b.Cols {
 		return false
 	}
 
 	if orientation == Horizontal {
-		if col+size > Cols {
+		if col+size > b.Cols {
 			return false
 		}
 		for j := 0; j < size; j++ {
@@ -60,7 +80,7 @@ func (b *Board) CanPlace(size, row, col int, orientation Orientation) bool {
 			}
 		}
 	} else {
-		if row+size > Rows {
+		if row+size > b.Rows {
 			return false
 		}
 		for i := 0; i < size; i++ {
@@ -69,9 +89,34 @@ func (b *Board) CanPlace(size, row, col int, orientation Orientation) bool {
 			}
 		}
 	}
+
+	if b.NoTouch && b.touchesShip(size, row, col, orientation) {
+		return false
+	}
 	return true
 }
 
+// touchesShip verifica se algum vizinho (inclusive diagonal) do navio
+// que seria colocado em (row, col) já tem navio.
+func (b *Board) touchesShip(size, row, col int, orientation Orientation) bool {
+	endRow, endCol := row, col+size-1
+	if orientation == Vertical {
+		endRow, endCol = row+size-1, col
+	}
+
+	for i := row - 1; i <= endRow+1; i++ {
+		for j := col - 1; j <= endCol+1; j++ {
+			if i < 0 || i >= b.Rows || j < 0 || j >= b.Cols {
+				continue
+			}
+			if b.Cells[i][j].State == Ship {
+				return true
+			}
+		}
+	}
+	return false
+}
+
 func (b *Board) PlaceShip(size, row, col int, orientation Orientation) {
 	if orientation == Horizontal {
 		for j := 0; j < size; j++ {
@@ -85,8 +130,8 @@ func (b *Board) PlaceShip(size, row, col int, orientation Orientation) {
 }
 
 func (b *Board) Clear() {
-	for i := 0; i < Rows; i++ {
-		for j := 0; j < Cols; j++ {
+	for i := 0; i < b.Rows; i++ {
+		for j := 0; j < b.Cols; j++ {
 			b.Cells[i][j].State = Empty
 		}
 	}
@@ -15,26 +15,35 @@ import (
 	"golang.org/x/image/font/opentype"
 )
 
-// cache da face para evitar recriar a cada frame
+// cache das faces por tamanho para evitar recriar a cada frame
+// (tabuleiros de dimensões diferentes usam tamanhos de label diferentes)
 var (
-	boardFace     font.Face
-	boardFaceOnce sync.Once
+	boardFaces   = map[int]font.Face{}
+	boardFacesMu sync.Mutex
 )
 
 func getBoardFace(labelSize float64) font.Face {
-	boardFaceOnce.Do(func() {
-		tt, _ := opentype.Parse(goregular.TTF)
-		boardFace, _ = opentype.NewFace(tt, &opentype.FaceOptions{
-			Size:    labelSize,
-			DPI:     72,
-			Hinting: font.HintingFull,
-		})
+	key := int(labelSize)
+
+	boardFacesMu.Lock()
+	defer boardFacesMu.Unlock()
+
+	if face, ok := boardFaces[key]; ok {
+		return face
+	}
+
+	tt, _ := opentype.Parse(goregular.TTF)
+	face, _ := opentype.NewFace(tt, &opentype.FaceOptions{
+		Size:    float64(key),
+		DPI:     72,
+		Hinting: font.HintingFull,
 	})
-	return boardFace
+	boardFaces[key] = face
+	return face
 }
 
 func (b *Board) Draw(screen *ebiten.Image) {
-	cellSize := b.Size / Cols
+	cellSize := b.CellSize()
 
 	if b.BackgroundImage != nil {
 		op := &ebiten.DrawImageOptions{}
@@ -49,11 +58,11 @@ func (b *Board) Draw(screen *ebiten.Image) {
 
 	// Draw Grid Lines (White)
 	gridColor := color.White
-	for i := 0; i <= Rows; i++ {
+	for i := 0; i <= b.Rows; i++ {
 		y := b.Y + float64(i)*cellSize
 		ebitenutil.DrawLine(screen, b.X, y, b.X+b.Size, y, gridColor)
 	}
-	for j := 0; j <= Cols; j++ {
+	for j := 0; j <= b.Cols; j++ {
 		x := b.X + float64(j)*cellSize
 		ebitenutil.DrawLine(screen, x, b.Y, x, b.Y+b.Size, gridColor)
 	}
@@ -68,18 +77,18 @@ func (b *Board) Draw(screen *ebiten.Image) {
 	face := getBoardFace(labelSize)
 	labelColor := color.White
 
-	// topo: letras A-H
-	for j := 0; j < Cols; j++ {
+	// topo: letras (A-J no tabuleiro padrão)
+	for j := 0; j < b.Cols; j++ {
 		ch := string(rune('A' + j))
 		x := int(b.X + float64(j)*cellSize + cellSize*0.3)
 		y := int(b.Y - 5)
 		text.Draw(screen, ch, face, x, y, labelColor)
 	}
 
-	// esquerda: números 1-7
-	for i := 0; i < Rows; i++ {
+	// esquerda: números (1-10 no tabuleiro padrão), recuando os de dois dígitos
+	for i := 0; i < b.Rows; i++ {
 		num := strconv.Itoa(i + 1)
-		x := int(b.X - cellSize*0.4)
+		x := int(b.X - cellSize*0.4 - float64(len(num)-1)*labelSize*0.5)
 		y := int(b.Y + float64(i)*cellSize + cellSize*0.7)
 		text.Draw(screen, num, face, x, y, labelColor)
 	}
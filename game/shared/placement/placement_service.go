@@ -1,17 +1,17 @@
-package service
+package placement
 
 import (
 	"math"
 	"math/rand"
+	"time"
 
 	"github.com/allanjose001/go-battleship/game/shared/board"
-	"github.com/allanjose001/go-battleship/game/shared/placement"
 )
 
 // PlacementRenderer descreve qualquer tipo capaz de desenhar o tabuleiro
 // de posicionamento e os navios, usado pela cena de placement.
 type PlacementRenderer interface {
-	Draw(b *board.Board, ships []*placement.ShipPlacement, active *placement.ShipPlacement, orientation board.Orientation)
+	Draw(b *board.Board, ships []*ShipPlacement, active *ShipPlacement, orientation board.Orientation)
 }
 
 // PlacementService define as operações de alto nível usadas pela cena
@@ -31,17 +31,23 @@ type PlacementService interface {
 // placementService é a implementação concreta de PlacementService.
 // Ela guarda o tabuleiro, a lista de navios e o estado de seleção/drag.
 type placementService struct {
+	rng         *rand.Rand
 	board       *board.Board
-	ships       []*placement.ShipPlacement
-	selected    *placement.ShipPlacement
-	activeShip  *placement.ShipPlacement
+	ships       []*ShipPlacement
+	selected    *ShipPlacement
+	activeShip  *ShipPlacement
 	orientation board.Orientation
 }
 
 // NewPlacementService cria um novo serviço de posicionamento com
-// orientação inicial horizontal.
-func NewPlacementService(b *board.Board, ships []*placement.ShipPlacement) PlacementService {
+// orientação inicial horizontal. rng é usado no posicionamento aleatório;
+// nil usa um gerador com semente de relógio.
+func NewPlacementService(b *board.Board, ships []*ShipPlacement, rng *rand.Rand) PlacementService {
+	if rng == nil {
+		rng = rand.New(rand.NewSource(time.Now().UnixNano()))
+	}
 	return &placementService{
+		rng:         rng,
 		board:       b,
 		ships:       ships,
 		orientation: board.Horizontal,
@@ -61,20 +67,31 @@ func (p *placementService) AllShipsPlaced() bool {
 
 // RandomPlacement limpa o tabuleiro visual e reposiciona todos os navios
 // aleatoriamente, atualizando também os metadados de cada ShipPlacement.
+// Recomeça do zero se algum navio não couber (possível com a regra NoTouch).
 func (p *placementService) RandomPlacement() {
+	for !p.tryRandomPlacement() {
+	}
+}
+
+// tryRandomPlacement faz uma tentativa de posicionamento aleatório completo.
+func (p *placementService) tryRandomPlacement() bool {
 	p.board.Clear()
 
 	for _, ship := range p.ships {
 		ship.Placed = false
 	}
 
-	var lastPlaced *placement.ShipPlacement
+	var lastPlaced *ShipPlacement
 
 	for _, ship := range p.ships {
-		for {
-			row := rand.Intn(board.Rows)
-			col := rand.Intn(board.Cols)
-			or := board.Orientation(rand.Intn(2))
+		for attempts := 0; !ship.Placed; attempts++ {
+			if attempts == 1000 {
+				return false
+			}
+
+			row := p.rng.Intn(p.board.Rows)
+			col := p.rng.Intn(p.board.Cols)
+			or := board.Orientation(p.rng.Intn(2))
 
 			if p.board.CanPlace(ship.Size, row, col, or) {
 				p.board.PlaceShip(ship.Size, row, col, or)
@@ -83,12 +100,12 @@ func (p *placementService) RandomPlacement() {
 				ship.Y = row
 				ship.Orientation = or
 				lastPlaced = ship
-				break
 			}
 		}
 	}
 
 	p.activeShip = lastPlaced
+	return true
 }
 
 // DropSelected tenta soltar o navio selecionado no tabuleiro,
@@ -101,7 +118,13 @@ func (p *placementService) DropSelected() bool {
 	ship := p.selected
 	ship.Dragging = false
 
-	cellSize := p.board.Size / float64(board.Cols)
+	// Navio já posicionado: tira do tabuleiro antes de validar o destino,
+	// senão as próprias células (e a margem da regra NoTouch) o impediriam
+	if ship.Placed {
+		p.removeShipFromBoard(ship)
+	}
+
+	cellSize := p.board.CellSize()
 
 	targetX := ship.DragX
 	targetY := ship.DragY
@@ -132,7 +155,7 @@ func (p *placementService) DropSelected() bool {
 
 // removeShipFromBoard limpa o tabuleiro e recoloca todos os navios
 // exceto o alvo, usado principalmente durante a rotação.
-func (p *placementService) removeShipFromBoard(target *placement.ShipPlacement) {
+func (p *placementService) removeShipFromBoard(target *ShipPlacement) {
 	if target == nil {
 		return
 	}
@@ -206,7 +229,7 @@ func (p *placementService) SelectOnBoard(mouseX, mouseY float64) bool {
 			continue
 		}
 
-		cellSize := p.board.Size / float64(board.Cols)
+		cellSize := p.board.CellSize()
 		x := p.board.X + float64(ship.X)*cellSize
 		y := p.board.Y + float64(ship.Y)*cellSize
 
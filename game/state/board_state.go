@@ -1,16 +1,31 @@
 package state
 
-import "github.com/allanjose001/go-battleship/game/shared/board"
+import (
+	"github.com/allanjose001/go-battleship/game/shared/board"
+	"github.com/allanjose001/go-battleship/game/shared/placement"
+	"github.com/allanjose001/go-battleship/internal/entity"
+)
 
+// GameState guarda o lado visual de uma batalha (geometria dos tabuleiros e sprites)
+// junto com os boards/frotas lógicos criados no placement. O entity.Match só
+// conhece a parte lógica; a parte visual fica aqui, no GameContext.
 type GameState struct {
 	PlayerBoard *board.Board
 	AIBoard     *board.Board
-	PlayerShips interface{} // Usaremos interface{} temporariamente ou criaremos um tipo compartilhado
+	PlayerShips []*placement.ShipPlacement // sprites na ordem de PlayerFleet.Ships
+	AIShips     []*placement.ShipPlacement // sprites na ordem de AIFleet.Ships
+
+	// Estado lógico (fonte da verdade); os boards visuais só guardam a geometria de desenho
+	PlayerEntityBoard *entity.Board
+	PlayerFleet       *entity.Fleet
+	AIEntityBoard     *entity.Board
+	AIFleet           *entity.Fleet
 }
 
-func NewGameState() *GameState {
+// NewGameState cria os dois tabuleiros com a mesma dimensão (rows x cols) da partida.
+func NewGameState(rows, cols int) *GameState {
 	return &GameState{
-		PlayerBoard: board.NewBoard(80, 150, 320),
-		AIBoard:     board.NewBoard(500, 150, 320),
+		PlayerBoard: board.NewBoard(80, 150, 320, rows, cols),
+		AIBoard:     board.NewBoard(500, 150, 320, rows, cols),
 	}
 }
@@ -1,20 +1,35 @@
 package state
 
 import (
+	"os"
+	"strconv"
+
 	"github.com/allanjose001/go-battleship/game/scenes/audio"
+	"github.com/allanjose001/go-battleship/internal/ai"
 	"github.com/allanjose001/go-battleship/internal/entity"
+	"github.com/allanjose001/go-battleship/internal/service"
 )
 
+// SeedEnvVar é a variável de ambiente que fixa a semente das partidas.
+const SeedEnvVar = "BATTLESHIP_SEED"
+
 // GameContext possui dados de interesse do jogo (tela de jogo, perfis, etc)
 type GameContext struct {
 	Profile              *entity.Profile
 	Match                *entity.Match
+	Battle               *GameState // lado visual (tabuleiros/sprites) da partida em Match
 	BattleService        BattleService
 	DynamicBattleService DynamicBattleService
 	SoundService         *audio.SoundService
+	Profiles             *service.ProfileService // perfis salvos (repositório injetado em NewGameContext)
 	Difficulty           string
+	BoardSize            int              // dimensão do tabuleiro escolhida para as próximas partidas
+	FleetSpec            entity.FleetSpec // composição de frota escolhida para as próximas partidas
+	Seed                 int64            // semente fixa para as próximas partidas (0 = aleatória)
 	IsCampaign           bool
 	IsDynamicMode        bool
+	IsSalvoMode          bool // modo Salvo: um tiro por navio vivo a cada turno
+	NoTouch              bool // regra opcional: navios não podem se encostar, nem na diagonal
 	CanPopOrPush         bool
 }
 
@@ -37,7 +52,11 @@ func NewGameContext() *GameContext {
 	ss.LoadSFX("backclick", "assets/audio/sfx/backclick.ogg")
 
 	return &GameContext{
+		Seed:         seedFromEnv(),
 		SoundService: ss,
+		Profiles:     service.NewDefaultProfileService(),
+		BoardSize:    entity.DefaultBoardSize,
+		FleetSpec:    entity.DefaultFleetSpec,
 		CanPopOrPush: true,
 	}
 }
@@ -49,6 +68,8 @@ type BattleService interface {
 	HandleEnemyTurn() (*entity.MatchResult, error)
 	Stats() (playerShots, playerHits, enemyShots, enemyHits int, isPlayerTurn bool)
 	WinnerName() string
+	SalvoStatus() (selected, shots int)
+	AIDebug() ai.DebugInfo
 }
 
 type DynamicBattleService interface {
@@ -64,6 +85,11 @@ func (c *GameContext) SetMatch(m *entity.Match) {
 	c.Match = m
 }
 
+// SetBattle define o estado visual da batalha associado ao Match atual.
+func (c *GameContext) SetBattle(gs *GameState) {
+	c.Battle = gs
+}
+
 func (c *GameContext) SetBattleService(s BattleService) {
 	c.BattleService = s
 }
@@ -75,3 +101,27 @@ func (c *GameContext) SetDynamicBattleService(s DynamicBattleService) {
 func (c *GameContext) SetDifficulty(d string) {
 	c.Difficulty = d
 }
+
+// SetFleetSpec define a composição de frota das próximas partidas.
+func (c *GameContext) SetFleetSpec(spec entity.FleetSpec) {
+	c.FleetSpec = spec
+}
+
+// SetSeed fixa a semente das próximas partidas (0 volta ao sorteio por relógio).
+func (c *GameContext) SetSeed(seed int64) {
+	c.Seed = seed
+}
+
+// seedFromEnv lê BATTLESHIP_SEED, usado para reproduzir uma partida reportada.
+func seedFromEnv() int64 {
+	seed, err := strconv.ParseInt(os.Getenv(SeedEnvVar), 10, 64)
+	if err != nil {
+		return 0
+	}
+	return seed
+}
+
+// SetBoardSize define a dimensão do tabuleiro, normalizando valores fora dos limites.
+func (c *GameContext) SetBoardSize(size int) {
+	c.BoardSize = entity.NormalizeBoardSize(size)
+}
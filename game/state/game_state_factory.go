@@ -0,0 +1,118 @@
+// GameStateFactory: cria o estado de batalha (GameState) a partir
+// do tabuleiro já configurado do jogador e da lista de navios
+// posicionados. Não conhece regras de ataque nem IA; foca apenas
+// em construir a estrutura de dados usada pela fase de batalha.
+package state
+
+import (
+	"fmt"
+	"math/rand"
+
+	"github.com/allanjose001/go-battleship/game/shared/board"
+	"github.com/allanjose001/go-battleship/game/shared/placement"
+	"github.com/allanjose001/go-battleship/internal/entity"
+	"github.com/allanjose001/go-battleship/internal/service"
+)
+
+type GameService struct{}
+
+func NewGameService() *GameService {
+	return &GameService{}
+}
+
+// NewBattleGameState:
+// - Reaproveita o board do jogador e clona as dimensões para o board da IA
+// - Converte os navios posicionados pelo jogador no board/frota lógicos dele
+// - Posiciona a frota da IA (mesma composição do jogador) direto no entity.Board dela,
+// seguindo a política de posicionamento do perfil da IA (aiPlacement)
+// - A regra NoTouch do board visual vale para os dois boards lógicos
+// - Devolve um GameState pronto para a BattleScene consumir
+// - rng: gerador da partida (entity.Match.Rand)
+func (g *GameService) NewBattleGameState(playerBoard *board.Board, ships []*placement.ShipPlacement, spec entity.FleetSpec, aiPlacement service.AIPlacement, rng *rand.Rand) *GameState {
+	gs := NewGameState(playerBoard.Rows, playerBoard.Cols)
+	gs.PlayerBoard = playerBoard
+	gs.PlayerShips = ships
+
+	gs.AIBoard.X = 1280 - playerBoard.X - playerBoard.Size
+	gs.AIBoard.Y = playerBoard.Y
+	gs.AIBoard.Size = playerBoard.Size
+
+	gs.PlayerEntityBoard, gs.PlayerFleet = g.BuildEntityBoard(ships, spec, playerBoard.Rows, playerBoard.NoTouch)
+
+	gs.AIFleet = entity.NewFleetFromSpec(spec)
+	gs.AIEntityBoard = entity.NewBoard(playerBoard.Rows)
+	gs.AIEntityBoard.NoTouch = playerBoard.NoTouch
+	service.NewAIFleetService(rng).PositionShips(gs.AIEntityBoard, gs.AIFleet, aiPlacement)
+
+	// placements da IA na ordem de gs.AIFleet.Ships (o renderer só usa os sprites)
+	gs.AIShips = fleetPlacements(gs.AIFleet)
+
+	return gs
+}
+
+// NewResumedGameState monta o lado visual de uma partida retomada do save:
+// os boards/frotas lógicos já vêm restaurados no Match, então só cria a
+// geometria dos tabuleiros (a mesma do placement) e um placement por navio,
+// na ordem das frotas. Os sprites ficam a cargo da cena.
+func (g *GameService) NewResumedGameState(m *entity.Match) *GameState {
+	size := m.PlayerEntityBoard.Size
+
+	gs := NewGameState(size, size)
+	gs.PlayerBoard = board.NewBoard(80, 100, 400, size, size)
+	gs.AIBoard = board.NewBoard(1280-80-400, 100, 400, size, size)
+	gs.PlayerBoard.NoTouch, gs.AIBoard.NoTouch = m.NoTouch, m.NoTouch
+
+	gs.PlayerEntityBoard, gs.PlayerFleet = m.PlayerEntityBoard, m.PlayerFleet
+	gs.AIEntityBoard, gs.AIFleet = m.EnemyEntityBoard, m.EnemyFleet
+
+	gs.PlayerShips = fleetPlacements(gs.PlayerFleet)
+	gs.AIShips = fleetPlacements(gs.AIFleet)
+	return gs
+}
+
+// fleetPlacements cria um placement por navio na ordem de fleet.Ships.
+func fleetPlacements(fleet *entity.Fleet) []*placement.ShipPlacement {
+	ships := make([]*placement.ShipPlacement, 0, len(fleet.Ships))
+	for _, ship := range fleet.Ships {
+		or := board.Vertical
+		if ship.Horizontal {
+			or = board.Horizontal
+		}
+		ships = append(ships, &placement.ShipPlacement{
+			Size:        ship.Size,
+			X:           ship.Col,
+			Y:           ship.Row,
+			Orientation: or,
+			Placed:      true,
+		})
+	}
+	return ships
+}
+
+// BuildEntityBoard constrói a representação lógica do tabuleiro e da frota
+// a partir dos navios posicionados visualmente, com a dimensão da partida.
+// Os placements seguem a ordem de spec.Sizes(), então o navio i da frota
+// corresponde ao placement i (sem precisar casar por tamanho).
+// noTouch liga a regra de navios sem contato no board lógico.
+func (g *GameService) BuildEntityBoard(ships []*placement.ShipPlacement, spec entity.FleetSpec, boardSize int, noTouch bool) (*entity.Board, *entity.Fleet) {
+	fleet := entity.NewFleetFromSpec(spec)
+	entityBoard := entity.NewBoard(boardSize)
+	entityBoard.NoTouch = noTouch
+
+	for i, ps := range ships {
+		if ps == nil || !ps.Placed {
+			continue
+		}
+		if i >= len(fleet.Ships) || fleet.Ships[i].Size != ps.Size {
+			fmt.Printf("ERRO: placement %d (tamanho %d) não corresponde à frota %q\n", i, ps.Size, spec.Name)
+			continue
+		}
+
+		entShip := fleet.Ships[i]
+		entShip.Horizontal = ps.Orientation == board.Horizontal
+		if !entityBoard.PlaceShip(entShip, ps.Y, ps.X) {
+			fmt.Printf("ERRO: Falha ao posicionar navio lógico (tamanho %d) em %d,%d\n", entShip.Size, ps.Y, ps.X)
+		}
+	}
+	return entityBoard, fleet
+}
@@ -1,19 +1,74 @@
 package ai
 
 import (
-	"fmt"
+	"math/rand"
+	"time"
 
 	"github.com/allanjose001/go-battleship/internal/entity"
 )
 
 type AIPlayer struct {
-	virtualBoard  [10][10]int
-	priorityQueue []Pair
-	Strategies    []Strategy
-	enemyFleet    *entity.Fleet
-	chaseMode     bool
-	ownBoard      *entity.Board
-	evasionQueue  []*entity.Ship // fila de navios que precisam ser movidos
+	boardSize      int
+	virtualBoard   [][]int
+	priorityQueue  []Pair
+	Strategies     []Strategy
+	knowsFleet     bool  // conhece a composição da frota inimiga (médio em diante)
+	enemyShips     []int // tamanhos dos navios inimigos que a IA ainda não viu afundar
+	history        []ShotResult
+	noTouch        bool // regra NoTouch da partida, informada pelo oráculo
+	chaseMode      bool
+	ownBoard       *entity.Board
+	evasionQueue   []*entity.Ship // fila de navios que precisam ser movidos
+	incomingHits   []incomingHit  // acertos do adversário na frota própria (ver EvasionStrategy)
+	evasionRest    int            // turnos de ataque obrigatórios depois de mover um navio
+	rng            *rand.Rand     // gerador da partida (ver SetRand)
+	salvoSpread    bool           // no modo Salvo, espalha os tiros de caça pelo tabuleiro (ver planSalvo)
+	placementPrior [][]float64    // hábitos de posicionamento do jogador (ver SetPlacementPrior)
+	lastStrategy   string         // estratégia da última jogada (ver Debug)
+	lastNote       string         // detalhe da última jogada, quando a estratégia tem o que contar (ver Debug)
+}
+
+// newAIPlayer cria a base comum das dificuldades com o tabuleiro virtual
+// dimensionado para a partida (boardSize x boardSize). Da frota inimiga a IA
+// só guarda a composição (tamanhos), que é pública; as posições nunca.
+func newAIPlayer(boardSize int, enemyFleet *entity.Fleet, strategies ...Strategy) *AIPlayer {
+	boardSize = entity.NormalizeBoardSize(boardSize)
+
+	virtualBoard := make([][]int, boardSize)
+	for i := range virtualBoard {
+		virtualBoard[i] = make([]int, boardSize)
+	}
+
+	var enemyShips []int
+	if enemyFleet != nil {
+		for _, ship := range enemyFleet.Ships {
+			if ship != nil {
+				enemyShips = append(enemyShips, ship.Size)
+			}
+		}
+	}
+
+	return &AIPlayer{
+		boardSize:    boardSize,
+		virtualBoard: virtualBoard,
+		knowsFleet:   enemyFleet != nil,
+		enemyShips:   enemyShips,
+		Strategies:   strategies,
+		rng:          rand.New(rand.NewSource(time.Now().UnixNano())),
+	}
+}
+
+// SetRand injeta o gerador da partida (entity.Match.Rand) para que as
+// decisões aleatórias da IA sejam reproduzíveis a partir da seed.
+func (ai *AIPlayer) SetRand(rng *rand.Rand) {
+	if rng != nil {
+		ai.rng = rng
+	}
+}
+
+// BoardSize retorna a dimensão do tabuleiro que a IA está atacando.
+func (ai *AIPlayer) BoardSize() int {
+	return ai.boardSize
 }
 
 func (ai *AIPlayer) SetOwnBoard(b *entity.Board) {
@@ -21,10 +76,10 @@ func (ai *AIPlayer) SetOwnBoard(b *entity.Board) {
 }
 
 // RegisterIncomingHit é chamado quando a IA é atingida.
-// Resolve o navio imediatamente (enquanto o board ainda está íntegro)
-// e o enfileira para evasão no próximo turno da IA.
+// Resolve o navio imediatamente (enquanto o board ainda está íntegro),
+// guarda o acerto para o mapa de ameaça e enfileira o navio para evasão.
 func (ai *AIPlayer) RegisterIncomingHit(row, col int) {
-	if ai.ownBoard == nil {
+	if ai.ownBoard == nil || !ai.ownBoard.InBounds(row, col) {
 		return
 	}
 	pos := ai.ownBoard.Positions[row][col]
@@ -35,113 +90,91 @@ func (ai *AIPlayer) RegisterIncomingHit(row, col int) {
 	// Só enfileira se o navio ainda não está destruído
 	// E se ainda não está na fila (evita duplicatas)
 	if ship.IsDestroyed() {
-		fmt.Printf("RegisterIncomingHit: navio '%s' destruído, sem evasão\n", ship.Name)
 		return
 	}
+	ai.incomingHits = append(ai.incomingHits, incomingHit{cell: entity.Cell{Row: row, Col: col}, ship: ship})
 	for _, s := range ai.evasionQueue {
 		if s == ship {
 			return // já enfileirado
 		}
 	}
-	fmt.Printf("RegisterIncomingHit: enfileirando '%s' para evasão\n", ship.Name)
 	ai.evasionQueue = append(ai.evasionQueue, ship)
 }
 
-func (ai *AIPlayer) dequeueEvasion() *entity.Ship {
-	if len(ai.evasionQueue) == 0 {
-		return nil
+// removeEvasion tira ship da fila de evasão.
+func (ai *AIPlayer) removeEvasion(ship *entity.Ship) {
+	for i, s := range ai.evasionQueue {
+		if s == ship {
+			ai.evasionQueue = append(ai.evasionQueue[:i], ai.evasionQueue[i+1:]...)
+			return
+		}
 	}
-	ship := ai.evasionQueue[0]
-	ai.evasionQueue = ai.evasionQueue[1:]
-	return ship
 }
 
-
-func (ai *AIPlayer) Attack(enemyBoard *entity.Board) {
+// Attack executa o turno da IA contra o oráculo do tabuleiro adversário:
+// a primeira estratégia que agir consome o turno e é devolvida (nil se nenhuma agiu).
+func (ai *AIPlayer) Attack(oracle ShotOracle) Strategy {
+	ai.lastNote = ""
 	for _, strat := range ai.Strategies { // verifica estrategias disponiveis
-		if strat.TryAttack(ai, enemyBoard) {
-			return
+		if strat.TryAttack(ai, oracle) {
+			ai.lastStrategy = StrategyName(strat)
+			return strat
 		}
 	}
+	ai.lastStrategy = StrategyName(nil)
+	return nil
 }
 
-func (ai *AIPlayer) AdjustStrategy(board *entity.Board, row, y int, ship *entity.Ship) {
-	if ship == nil {
-		ai.virtualBoard[row][y] = 1
-		return
-	}
+// Observe registra a resposta de um tiro no tabuleiro virtual e no histórico
+// e ajusta a estratégia (fila de prioridade, perseguição, navios restantes).
+func (ai *AIPlayer) Observe(res ShotResult) {
+	ai.history = append(ai.history, res)
+	row, y := res.Row, res.Col
 
-	if ship.IsDestroyed() {
-		ai.virtualBoard[row][y] = 3
-		//ai.WreckedShipAdjustment(board, row, y)
+	switch res.Outcome {
+	case ShotMiss:
+		ai.virtualBoard[row][y] = 1
+	case ShotSunk:
+		wreck := ai.resolveSunk(row, y, res.SunkSize)
+		for _, p := range wreck {
+			ai.virtualBoard[p.row][p.col] = 3
+		}
+		if ai.noTouch {
+			// sem navios encostados, a vizinhança do navio afundado é água
+			ai.WreckedShipAdjustment(wreck)
+		}
 		ai.ClearPriorityQueue()
-		ai.FleetShipDestroyed(ship) // <- enviar ponteiro do navio
+		ai.enemyShipSunk(res.SunkSize)
 		ai.StopChase()
-	} else {
+	default:
 		ai.virtualBoard[row][y] = 2
 		ai.AttackNeighbors(row, y)
 	}
 }
 
-// retorna o tamanho do proximo navio da enemyFleet
+// retorna o tamanho do proximo navio inimigo ainda vivo
 func (ai *AIPlayer) SizeOfNextShip() int {
-	for _, ship := range ai.enemyFleet.Ships {
-		if ship != nil && !ship.IsDestroyed() {
-			return ship.Size
-		}
+	if len(ai.enemyShips) == 0 {
+		return 0
 	}
-	return 0
+	return ai.enemyShips[0]
 }
 
-// marca o navio como destruído na fleet interna do AI (por referência)
-// fallback: marca por size caso ponteiro não exista (evita inconsistência)
-func (ai *AIPlayer) FleetShipDestroyed(ship *entity.Ship) {
-	if ship == nil || ai.enemyFleet == nil {
-		return
-	}
-	for i, s := range ai.enemyFleet.Ships {
-		if s == ship {
-			ai.enemyFleet.Ships[i].HitCount = s.Size
-			fmt.Printf("FleetShipDestroyed: matched by pointer index=%d size=%d\n", i, s.Size)
-			return
-		}
-	}
-	// fallback: marcar primeiro barco com o mesmo tamanho que não foi destruido
-	for i, s := range ai.enemyFleet.Ships {
-		if s != nil && s.Size == ship.Size && !s.IsDestroyed() {
-			ai.enemyFleet.Ships[i].HitCount = s.Size
-			fmt.Printf("FleetShipDestroyed: fallback matched index=%d size=%d\n", i, s.Size)
+// enemyShipSunk tira um navio do tamanho informado da lista de navios inimigos vivos.
+func (ai *AIPlayer) enemyShipSunk(size int) {
+	for i, s := range ai.enemyShips {
+		if s == size {
+			ai.enemyShips = append(ai.enemyShips[:i], ai.enemyShips[i+1:]...)
 			return
 		}
 	}
 }
 
-// Retorna a posição inicial do navio (mais à esquerda se horizontal, mais acima se vertical)
-func (ai *AIPlayer) LocateShipStart(board *entity.Board, row, col int) (startRow, startCol int) {
-	ship := entity.GetShipReference(board.Positions[row][col])
-	if ship == nil {
-		return row, col
-	}
-	startRow, startCol = row, col
-
-	if ship.IsHorizontal() {
-		for startCol > 0 && entity.GetShipReference(board.Positions[row][startCol-1]) == ship {
-			startCol--
-		}
-	} else {
-		for startRow > 0 && entity.GetShipReference(board.Positions[startRow-1][col]) == ship {
-			startRow--
-		}
-	}
-
-	return
-}
-
 // Procura verticalmente por uma sequência de posições vazias com tamanho suficiente para o próximo navio
 func (ai *AIPlayer) SearchVertically(size int) bool {
-	for j := 0; j < 10; j++ {
+	for j := 0; j < ai.boardSize; j++ {
 		contiguous := 0
-		for i := 0; i < 10; i++ {
+		for i := 0; i < ai.boardSize; i++ {
 			if ai.virtualBoard[i][j] == 0 {
 				contiguous++
 			} else {
@@ -151,8 +184,8 @@ func (ai *AIPlayer) SearchVertically(size int) bool {
 				targetRow := i - size/2
 				if targetRow < 0 {
 					targetRow = 0
-				} else if targetRow > 9 {
-					targetRow = 9
+				} else if targetRow > ai.boardSize-1 {
+					targetRow = ai.boardSize - 1
 				}
 				ai.AddToPriorityQueue(targetRow, j)
 				return true
@@ -164,9 +197,9 @@ func (ai *AIPlayer) SearchVertically(size int) bool {
 
 // Procura horizontalmente por uma sequência de posições vazias com tamanho suficiente para o próximo navio
 func (ai *AIPlayer) SearchHorizontally(size int) bool {
-	for i := 0; i < 10; i++ {
+	for i := 0; i < ai.boardSize; i++ {
 		contiguous := 0
-		for j := 0; j < 10; j++ {
+		for j := 0; j < ai.boardSize; j++ {
 			if ai.virtualBoard[i][j] == 0 {
 				contiguous++
 			} else {
@@ -176,8 +209,8 @@ func (ai *AIPlayer) SearchHorizontally(size int) bool {
 				targetCol := j - size/2
 				if targetCol < 0 {
 					targetCol = 0
-				} else if targetCol > 9 {
-					targetCol = 9
+				} else if targetCol > ai.boardSize-1 {
+					targetCol = ai.boardSize - 1
 				}
 				ai.AddToPriorityQueue(i, targetCol)
 				return true
@@ -187,39 +220,17 @@ func (ai *AIPlayer) SearchHorizontally(size int) bool {
 	return false
 }
 
-func (ai *AIPlayer) WreckedShipAdjustment(board *entity.Board, row, col int) {
-	ship := entity.GetShipReference(board.Positions[row][col])
-	if ship == nil {
-		return
-	}
-
-	startRow, endRow := row, row
-	startCol, endCol := col, col
-
-	// vertical
-	for startRow > 0 && entity.GetShipReference(board.Positions[startRow-1][col]) == ship {
-		startRow--
-	}
-	for endRow < 9 && entity.GetShipReference(board.Positions[endRow+1][col]) == ship {
-		endRow++
-	}
-
-	// horizontal
-	for startCol > 0 && entity.GetShipReference(board.Positions[row][startCol-1]) == ship {
-		startCol--
-	}
-	for endCol < 9 && entity.GetShipReference(board.Positions[row][endCol+1]) == ship {
-		endCol++
-	}
-
-	adjStartRow := max(0, startRow-1)
-	adjEndRow := min(9, endRow+1)
-	adjStartCol := max(0, startCol-1)
-	adjEndCol := min(9, endCol+1)
-
-	for i := adjStartRow; i <= adjEndRow; i++ {
-		for j := adjStartCol; j <= adjEndCol; j++ {
-			ai.virtualBoard[i][j] = 3
+// WreckedShipAdjustment marca como água as células desconhecidas em volta das
+// células conhecidas de um navio afundado; só vale com a regra NoTouch, em que
+// nenhum navio encosta em outro.
+func (ai *AIPlayer) WreckedShipAdjustment(wreck []Pair) {
+	for _, p := range wreck {
+		for i := p.row - 1; i <= p.row+1; i++ {
+			for j := p.col - 1; j <= p.col+1; j++ {
+				if ai.IsValid(i, j) {
+					ai.virtualBoard[i][j] = 1 // vizinhança: marcada como água
+				}
+			}
 		}
 	}
 }
@@ -236,12 +247,10 @@ func (ai *AIPlayer) AddToPriorityQueue(row, col int) {
 		}
 	}
 	ai.priorityQueue = append(ai.priorityQueue, Pair{row, col})
-	fmt.Printf("PQ: push %d,%d len=%d\n", row, col, len(ai.priorityQueue))
 }
 
 func (ai *AIPlayer) ClearPriorityQueue() {
 	ai.priorityQueue = nil
-	fmt.Println("PQ: cleared")
 }
 
 // Adiciona posições vizinhas à fila de prioridade
@@ -254,7 +263,7 @@ func (ai *AIPlayer) AttackNeighbors(row, col int) {
 
 // Verifica se a posição é válida para atacar (dentro do tabuleiro e ainda não marcada)
 func (ai *AIPlayer) IsValid(row, col int) bool {
-	if row < 0 || row >= 10 || col < 0 || col >= 10 {
+	if !ai.IsValidForTesting(row, col) {
 		return false
 	}
 	return ai.virtualBoard[row][col] == 0
@@ -262,7 +271,7 @@ func (ai *AIPlayer) IsValid(row, col int) bool {
 
 // Apenas checa se a posição está dentro do tabuleiro
 func (ai *AIPlayer) IsValidForTesting(row, col int) bool {
-	return row >= 0 && row < 10 && col >= 0 && col < 10
+	return row >= 0 && row < ai.boardSize && col >= 0 && col < ai.boardSize
 }
 
 func (ai *AIPlayer) PopPriority() (row, y int) {
@@ -272,7 +281,6 @@ func (ai *AIPlayer) PopPriority() (row, y int) {
 
 	p := ai.priorityQueue[0]
 	ai.priorityQueue = ai.priorityQueue[1:]
-	fmt.Printf("PQ: pop %d,%d len=%d\n", p.row, p.col, len(ai.priorityQueue))
 	return p.row, p.col
 }
 
@@ -288,16 +296,18 @@ func (ai *AIPlayer) IsChasing() bool {
 	return ai.chaseMode
 }
 
-// Ataca posições estratégicas quando 30% do tabuleiro estiver preenchido
-func (ai *AIPlayer) ShouldAttackStrategicPositions() bool {
+// Ataca posições estratégicas quando a fração threshold do tabuleiro estiver
+// preenchida (DefaultStrategicThreshold = 30%)
+func (ai *AIPlayer) ShouldAttackStrategicPositions(threshold float64) bool {
 	filled := 0
 
-	for i := 0; i < 10; i++ {
-		for j := 0; j < 10; j++ {
+	for i := 0; i < ai.boardSize; i++ {
+		for j := 0; j < ai.boardSize; j++ {
 			if ai.virtualBoard[i][j] != 0 {
 				filled++
 			}
 		}
 	}
-	return float64(filled)/100.0 >= 0.3
+	total := float64(ai.boardSize * ai.boardSize)
+	return float64(filled)/total >= threshold
 }
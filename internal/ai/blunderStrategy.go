@@ -0,0 +1,21 @@
+package ai
+
+import "fmt"
+
+// BlunderStrategy é um handicap: com probabilidade Chance (0 a 1) a IA ignora
+// o que sabe e gasta o turno em um tiro aleatório. Fica no topo da pilha dos
+// perfis que precisam jogar abaixo da força das outras estratégias.
+type BlunderStrategy struct {
+	Chance float64
+}
+
+func (s *BlunderStrategy) TryAttack(ai *AIPlayer, oracle ShotOracle) bool {
+	if s.Chance <= 0 || ai.rng.Float64() >= s.Chance {
+		return false
+	}
+	if !(&RandomStrategy{}).TryAttack(ai, oracle) {
+		return false
+	}
+	ai.lastNote = fmt.Sprintf("erro proposital (chance de %.0f%%)", s.Chance*100)
+	return true
+}
@@ -0,0 +1,58 @@
+package ai
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/allanjose001/go-battleship/internal/entity"
+)
+
+// Estados das células do tabuleiro virtual da IA (DebugInfo.VirtualBoard).
+const (
+	CellUnknown = 0 // ainda não atacada nem deduzida
+	CellMiss    = 1 // água (tiro ou dedução)
+	CellHit     = 2 // acerto de navio ainda não afundado
+	CellSunk    = 3 // parte de navio afundado
+)
+
+// DebugInfo é uma fotografia do raciocínio da IA, para o overlay de debug.
+type DebugInfo struct {
+	VirtualBoard [][]int       // cópia do tabuleiro virtual (Cell*)
+	Queue        []entity.Cell // fila de prioridade, na ordem em que será atacada
+	Chasing      bool          // modo perseguição
+	LastStrategy string        // estratégia que fez a última jogada ("" antes da primeira)
+	EnemyShips   []int         // tamanhos dos navios inimigos que a IA acha que estão vivos
+	LastNote     string        // detalhe da última jogada (ex.: navio movido); "" se não houver
+}
+
+// Debug devolve o estado atual da IA. Tudo é copiado: a fotografia não muda
+// com as próximas jogadas.
+func (ai *AIPlayer) Debug() DebugInfo {
+	board := make([][]int, len(ai.virtualBoard))
+	for i := range ai.virtualBoard {
+		board[i] = append([]int(nil), ai.virtualBoard[i]...)
+	}
+
+	queue := make([]entity.Cell, 0, len(ai.priorityQueue))
+	for _, p := range ai.priorityQueue {
+		queue = append(queue, entity.Cell{Row: p.row, Col: p.col})
+	}
+
+	return DebugInfo{
+		VirtualBoard: board,
+		Queue:        queue,
+		Chasing:      ai.chaseMode,
+		LastStrategy: ai.lastStrategy,
+		EnemyShips:   append([]int(nil), ai.enemyShips...),
+		LastNote:     ai.lastNote,
+	}
+}
+
+// StrategyName devolve o nome do tipo da estratégia ("nenhuma" se nil).
+func StrategyName(s Strategy) string {
+	if s == nil {
+		return "nenhuma"
+	}
+	name := fmt.Sprintf("%T", s)
+	return name[strings.LastIndex(name, ".")+1:]
+}
@@ -2,49 +2,26 @@ package ai
 
 import "github.com/allanjose001/go-battleship/internal/entity"
 
-func NewEasyAIPlayer() *AIPlayer {
-	return &AIPlayer{
-		Strategies: []Strategy{
-			&RandomStrategy{},
-		},
-	}
+// Os construtores abaixo criam as IAs dos perfis embutidos (ver profile.go).
+
+func NewEasyAIPlayer(boardSize int) *AIPlayer {
+	return EasyProfile.mustBuild(nil, boardSize, nil)
+}
+
+func NewMediumAIPlayer(enemyFleet *entity.Fleet, boardSize int) *AIPlayer {
+	return MediumProfile.mustBuild(enemyFleet, boardSize, nil)
 }
 
-func NewMediumAIPlayer(enemyFleet *entity.Fleet) *AIPlayer {
-	return &AIPlayer{
-		enemyFleet: enemyFleet,
-		Strategies: []Strategy{
-			&PartialLineStrategy{},
-			&DiscoveryStrategy{},
-			&RandomStrategy{},
-		},
-	}
+func NewHardAIPlayer(enemyFleet *entity.Fleet, boardSize int) *AIPlayer {
+	return HardProfile.mustBuild(enemyFleet, boardSize, nil)
 }
 
-func NewHardAIPlayer(enemyFleet *entity.Fleet) *AIPlayer {
-	return &AIPlayer{
-		enemyFleet: enemyFleet,
-		Strategies: []Strategy{
-			&StrategicSearchStrategy{},
-			&FullLineStrategy{},
-			&DiscoveryStrategy{},
-			&RandomStrategy{},
-		},
-	}
+// NewExpertAIPlayer mira pela densidade de probabilidade dos navios restantes;
+// a estratégia aleatória só entra se a densidade não achar alvo.
+func NewExpertAIPlayer(enemyFleet *entity.Fleet, boardSize int) *AIPlayer {
+	return ExpertProfile.mustBuild(enemyFleet, boardSize, nil)
 }
 
 func NewDynamicAIPlayer(enemyFleet *entity.Fleet, ownBoard *entity.Board) *AIPlayer {
-	return &AIPlayer{
-		enemyFleet:   enemyFleet,
-		ownBoard:     ownBoard,
-		evasionQueue: make([]*entity.Ship, 0), // inicializa fila vazia
-		Strategies: []Strategy{
-			//&EvasionStrategy{},
-			&RandomMoveStrategy{Chance: 40}, // 2º: move aleatoriamente 40% das vezes
-			&StrategicSearchStrategy{},
-			&FullLineStrategy{},
-			&DiscoveryStrategy{},
-			&RandomStrategy{},
-		},
-	}
+	return DynamicProfile.mustBuild(enemyFleet, ownBoard.Size, ownBoard)
 }
@@ -1,13 +1,8 @@
 package ai
 
-import "github.com/allanjose001/go-battleship/internal/entity"
-import "fmt"
-
 type DiscoveryStrategy struct{}
 
-func (s *DiscoveryStrategy) TryAttack(ai *AIPlayer, board *entity.Board) bool {
-	fmt.Println("chegou em discoveryStrategy")
-
+func (s *DiscoveryStrategy) TryAttack(ai *AIPlayer, oracle ShotOracle) bool {
 	if ai.IsChasing() {
 		return false
 	}
@@ -17,7 +12,6 @@ func (s *DiscoveryStrategy) TryAttack(ai *AIPlayer, board *entity.Board) bool {
 
 	// Pega a primeira posição da fila de prioridade
 	row, col := ai.PopPriority()
-	ship := board.AttackPositionB(row, col)
-	ai.AdjustStrategy(board, row, col, ship)
-	return true
+	_, err := ai.Fire(oracle, row, col)
+	return err == nil
 }
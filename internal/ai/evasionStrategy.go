@@ -2,58 +2,239 @@ package ai
 
 import (
 	"fmt"
-	"math/rand"
 
 	"github.com/allanjose001/go-battleship/internal/entity"
 )
 
-type EvasionStrategy struct{}
+const (
+	// DefaultEvasionThreshold é a queda mínima de ameaça para a IA mover em vez de atacar.
+	DefaultEvasionThreshold = 2.0
+	// DefaultEvasionCooldown é quantos turnos a IA ataca depois de mover um navio.
+	DefaultEvasionCooldown = 1
 
-func (s *EvasionStrategy) TryAttack(ai *AIPlayer, board *entity.Board) bool {
-	fmt.Println("chegou em evasionStrategy")
+	// evasionHitWeight é a ameaça de uma célula colada (na linha) a um acerto do
+	// adversário; cai com a distância, como a perseguição de quem acertou.
+	evasionHitWeight = 4.0
+	// evasionHitReach é até onde, na linha do acerto, a perseguição ameaça.
+	evasionHitReach = 3
+	// evasionShotWeight é a ameaça de uma célula vizinha a um tiro qualquer:
+	// o adversário tende a continuar atirando na região que está varrendo.
+	evasionShotWeight = 0.5
+	// evasionShotReach é o raio (distância de Chebyshev) da pressão dos tiros.
+	evasionShotReach = 2
+	// evasionExposedWeight é a ameaça de continuar sobre um acerto: o adversário
+	// sabe que há um navio ali.
+	evasionExposedWeight = 4.0
+)
+
+// incomingHit é um acerto do adversário na frota própria e o navio atingido.
+type incomingHit struct {
+	cell entity.Cell
+	ship *entity.Ship
+}
+
+// EvasionStrategy é a IA do modo dinâmico que foge do fogo do adversário.
+// A cada turno monta um mapa de ameaça com os tiros recebidos (perseguição em
+// linha a partir dos acertos e a região que o adversário está varrendo) e
+// avalia todos os movimentos possíveis da frota própria. Só gasta o turno
+// movendo se o melhor movimento reduzir a ameaça em pelo menos Threshold;
+// depois de mover, ataca por Cooldown turnos antes de poder mover de novo.
+type EvasionStrategy struct {
+	Threshold float64
+	Cooldown  int
+}
+
+// evasionMove é um movimento candidato e a queda de ameaça que ele traz.
+type evasionMove struct {
+	ship     *entity.Ship
+	row, col int
+	gain     float64
+}
 
-	if ai.ownBoard == nil || len(ai.evasionQueue) == 0 {
-		fmt.Println("evasionStrategy: sem navios para evadir")
+func (s *EvasionStrategy) TryAttack(ai *AIPlayer, oracle ShotOracle) bool {
+	if ai.ownBoard == nil {
 		return false
 	}
+	ai.pruneIncomingHits()
 
-	ship := ai.dequeueEvasion()
+	if ai.evasionRest > 0 {
+		ai.evasionRest--
+		return false
+	}
+
+	// com um acerto pendente no adversário, atacar vale mais: exige o dobro
+	threshold := s.Threshold
+	if ai.hasPendingHit() {
+		threshold *= 2
+	}
 
-	// Navio destruído entre o hit e o turno da IA: descarta silenciosamente
-	if ship.IsDestroyed() {
-		fmt.Printf("evasionStrategy: '%s' destruído antes da evasão, descartando\n", ship.Name)
+	best, ok := ai.bestEvasion()
+	if !ok || best.gain < threshold {
 		return false
 	}
 
-	topR, topC := findShipTopLeft(ai.ownBoard, ship)
-	if topR == -1 {
-		fmt.Printf("evasionStrategy: '%s' não encontrado no board\n", ship.Name)
+	if err := ai.ownBoard.MoveShip(best.ship, best.row, best.col); err != nil {
 		return false
 	}
+	ai.lastNote = fmt.Sprintf("'%s' movido para (%d,%d), ameaça -%.2f", best.ship.Name, best.row, best.col, best.gain)
+
+	ai.removeEvasion(best.ship)
+	ai.evasionRest = s.Cooldown
+	return true // consome o turno: IA moveu, não ataca
+}
+
+// bestEvasion avalia os movimentos de todos os navios vivos da IA e devolve o
+// de maior queda de ameaça. Os navios da fila de evasão (já atingidos) vêm
+// primeiro e ganham os empates; o resto é embaralhado para evitar viés.
+func (ai *AIPlayer) bestEvasion() (evasionMove, bool) {
+	threat := ai.threatMap()
+	hits := ai.incomingHitShips()
+
+	ships := collectAliveShips(ai.ownBoard)
+	ai.rng.Shuffle(len(ships), func(i, j int) { ships[i], ships[j] = ships[j], ships[i] })
+	ships = append(append([]*entity.Ship(nil), ai.evasionQueue...), ships...)
 
 	dirs := []entity.Direction{entity.Up, entity.Down, entity.Left, entity.Right}
-	rand.Shuffle(len(dirs), func(i, j int) { dirs[i], dirs[j] = dirs[j], dirs[i] })
+	ai.rng.Shuffle(len(dirs), func(i, j int) { dirs[i], dirs[j] = dirs[j], dirs[i] })
 
-	for _, dir := range dirs {
-		dr, dc := dirToDeltas(dir)
-		newRow := topR + dr
-		newCol := topC + dc
+	var best evasionMove
+	found := false
+	seen := make(map[*entity.Ship]bool)
+	for _, ship := range ships {
+		if seen[ship] || ship.IsDestroyed() {
+			continue
+		}
+		seen[ship] = true
 
-		if err := ai.ownBoard.MoveShip(ship, newRow, newCol); err == nil {
-			fmt.Printf("evasionStrategy: '%s' movido de (%d,%d) para (%d,%d)\n",
-				ship.Name, topR, topC, newRow, newCol)
-			return true // <- consome o turno: IA moveu, não ataca
+		topR, topC := findShipTopLeft(ai.ownBoard, ship)
+		if topR == -1 {
+			continue
 		}
+		current := shipThreat(threat, hits, ship, topR, topC)
+
+		for _, dir := range dirs {
+			dr, dc := dirToDeltas(dir)
+			row, col := topR+dr, topC+dc
+			if ai.ownBoard.CanMoveShip(ship, row, col) != nil {
+				continue
+			}
+			gain := current - shipThreat(threat, hits, ship, row, col)
+			if !found || gain > best.gain {
+				best = evasionMove{ship: ship, row: row, col: col, gain: gain}
+				found = true
+			}
+		}
+	}
+	return best, found
+}
+
+// threatMap estima, para cada célula do tabuleiro próprio, o quanto o
+// adversário tende a atirar nela em breve. Células já atacadas valem 0.
+func (ai *AIPlayer) threatMap() [][]float64 {
+	b := ai.ownBoard
+	threat := make([][]float64, b.Size)
+	for i := range threat {
+		threat[i] = make([]float64, b.Size)
 	}
 
-	fmt.Printf("evasionStrategy: '%s' não pôde ser movido (sem espaço), atacando normalmente\n", ship.Name)
-	return false // <- só cai aqui se nenhuma direção foi possível
+	hitCells := ai.incomingHitShips()
+
+	// pressão da região varrida: vizinhos de qualquer tiro
+	for r := 0; r < b.Size; r++ {
+		for c := 0; c < b.Size; c++ {
+			if !entity.IsAttacked(b.Positions[r][c]) {
+				continue
+			}
+			for dr := -evasionShotReach; dr <= evasionShotReach; dr++ {
+				for dc := -evasionShotReach; dc <= evasionShotReach; dc++ {
+					d := max(abs(dr), abs(dc))
+					if d > 0 && b.InBounds(r+dr, c+dc) {
+						threat[r+dr][c+dc] += evasionShotWeight / float64(d)
+					}
+				}
+			}
+		}
+	}
+
+	// perseguição: a partir de cada acerto, nas quatro direções até uma água conhecida
+	for _, h := range ai.incomingHits {
+		for _, dir := range []entity.Direction{entity.Up, entity.Down, entity.Left, entity.Right} {
+			dr, dc := dirToDeltas(dir)
+			for k := 1; k <= evasionHitReach; k++ {
+				r, c := h.cell.Row+dr*k, h.cell.Col+dc*k
+				if !b.InBounds(r, c) {
+					break
+				}
+				if _, hit := hitCells[entity.Cell{Row: r, Col: c}]; !hit && entity.IsAttacked(b.Positions[r][c]) {
+					break
+				}
+				threat[r][c] += evasionHitWeight / float64(k)
+			}
+		}
+	}
+
+	for r := 0; r < b.Size; r++ {
+		for c := 0; c < b.Size; c++ {
+			if entity.IsAttacked(b.Positions[r][c]) {
+				threat[r][c] = 0
+			}
+		}
+	}
+	return threat
+}
+
+// incomingHitShips indexa os acertos do adversário pela célula.
+func (ai *AIPlayer) incomingHitShips() map[entity.Cell]*entity.Ship {
+	hits := make(map[entity.Cell]*entity.Ship, len(ai.incomingHits))
+	for _, h := range ai.incomingHits {
+		hits[h.cell] = h.ship
+	}
+	return hits
+}
+
+// shipThreat soma a ameaça das células que ship ocuparia com o canto superior
+// esquerdo em (row, col), mais a exposição de cada acerto dele que continuaria coberto.
+func shipThreat(threat [][]float64, hits map[entity.Cell]*entity.Ship, ship *entity.Ship, row, col int) float64 {
+	total := 0.0
+	for i := 0; i < ship.Size; i++ {
+		r, c := row+i, col
+		if ship.IsHorizontal() {
+			r, c = row, col+i
+		}
+		if r >= 0 && r < len(threat) && c >= 0 && c < len(threat[r]) {
+			total += threat[r][c]
+		}
+		if hits[entity.Cell{Row: r, Col: c}] == ship {
+			total += evasionExposedWeight
+		}
+	}
+	return total
+}
+
+// pruneIncomingHits esquece os acertos de navios já afundados (o adversário
+// não persegue mais) e tira esses navios da fila de evasão.
+func (ai *AIPlayer) pruneIncomingHits() {
+	hits := ai.incomingHits[:0]
+	for _, h := range ai.incomingHits {
+		if !h.ship.IsDestroyed() {
+			hits = append(hits, h)
+		}
+	}
+	ai.incomingHits = hits
+
+	queue := ai.evasionQueue[:0]
+	for _, ship := range ai.evasionQueue {
+		if !ship.IsDestroyed() {
+			queue = append(queue, ship)
+		}
+	}
+	ai.evasionQueue = queue
 }
 
 func findShipTopLeft(b *entity.Board, ship *entity.Ship) (int, int) {
 	minR, minC := -1, -1
-	for r := 0; r < entity.BoardSize; r++ {
-		for c := 0; c < entity.BoardSize; c++ {
+	for r := 0; r < b.Size; r++ {
+		for c := 0; c < b.Size; c++ {
 			if entity.GetShipReference(b.Positions[r][c]) == ship {
 				if minR == -1 || r < minR || (r == minR && c < minC) {
 					minR, minC = r, c
@@ -1,24 +1,18 @@
 package ai
 
-import (
-	"fmt"
-
-	"github.com/allanjose001/go-battleship/internal/entity"
-)
-
 type FullLineStrategy struct{}
 
-func (s *FullLineStrategy) TryAttack(ai *AIPlayer, board *entity.Board) bool {
-	fmt.Println("chegou em fullLineStrategy")
-
+func (s *FullLineStrategy) TryAttack(ai *AIPlayer, oracle ShotOracle) bool {
 	if len(ai.priorityQueue) == 0 {
 		return false
 	}
 	for len(ai.priorityQueue) > 0 {
 		row, col := ai.PopPriority()
-		ship := board.AttackPositionB(row, col)
-		ai.AdjustStrategy(board, row, col, ship)
-		if ship == nil || ship.IsDestroyed() {
+		res, err := ai.Fire(oracle, row, col)
+		if err != nil {
+			continue // alvo já atacado: tenta o próximo da fila
+		}
+		if res.Outcome != ShotHit {
 			return true
 		}
 
@@ -85,5 +79,5 @@ func (s *FullLineStrategy) TryAttack(ai *AIPlayer, board *entity.Board) bool {
 		}
 		return true
 	}
-	return true
+	return false
 }
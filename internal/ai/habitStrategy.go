@@ -0,0 +1,89 @@
+package ai
+
+// DefaultHabitWeight é o peso padrão dos hábitos do jogador na caça.
+const DefaultHabitWeight = 2.0
+
+// HabitStrategy caça usando os hábitos de posicionamento do jogador (ver
+// SetPlacementPrior): cada célula desconhecida vale a densidade de probabilidade
+// dos navios restantes multiplicada por 1 + Weight * frequência com que o jogador
+// pôs navio nela. Só age na caça (sem acertos pendentes) e quando há hábitos.
+type HabitStrategy struct {
+	// Weight é o quanto os hábitos pesam (0 caça só pela densidade; o perfil
+	// sem o parâmetro usa DefaultHabitWeight).
+	Weight float64
+}
+
+func (s *HabitStrategy) TryAttack(ai *AIPlayer, oracle ShotOracle) bool {
+	if ai.placementPrior == nil || len(ai.priorityQueue) > 0 || ai.hasPendingHit() {
+		return false
+	}
+
+	row, col, ok := ai.pickByHabit(s.Weight)
+	if !ok {
+		return false
+	}
+
+	_, err := ai.Fire(oracle, row, col)
+	return err == nil
+}
+
+// SetPlacementPrior informa onde o jogador costuma posicionar os navios: para
+// cada célula, a fração das partidas anteriores em que havia navio (0 a 1).
+// Um mapa de outra dimensão é ignorado.
+func (ai *AIPlayer) SetPlacementPrior(prior [][]float64) {
+	if len(prior) != ai.boardSize {
+		ai.placementPrior = nil
+		return
+	}
+	ai.placementPrior = prior
+}
+
+// hasPendingHit indica se há acerto ainda não associado a um navio afundado.
+func (ai *AIPlayer) hasPendingHit() bool {
+	for i := range ai.virtualBoard {
+		for j := range ai.virtualBoard[i] {
+			if ai.virtualBoard[i][j] == 2 {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// pickByHabit escolhe a célula de maior nota pela densidade pesada pelos hábitos.
+// Sem conhecer a frota, a nota é só a frequência dos hábitos.
+func (ai *AIPlayer) pickByHabit(weight float64) (row, col int, ok bool) {
+	density := ai.ProbabilityDensity()
+
+	best := 0.0
+	var ties []Pair
+	for i := 0; i < ai.boardSize; i++ {
+		for j := 0; j < ai.boardSize; j++ {
+			if !ai.IsValid(i, j) || j >= len(ai.placementPrior[i]) {
+				continue
+			}
+
+			prior := ai.placementPrior[i][j]
+			score := prior
+			if density != nil {
+				score = float64(density[i][j]) * (1 + weight*prior)
+			}
+			if score <= 0 {
+				continue
+			}
+
+			if score > best {
+				best = score
+				ties = ties[:0]
+			}
+			if score == best {
+				ties = append(ties, Pair{i, j})
+			}
+		}
+	}
+	if len(ties) == 0 {
+		return -1, -1, false
+	}
+	p := ties[ai.rng.Intn(len(ties))]
+	return p.row, p.col, true
+}
@@ -0,0 +1,162 @@
+package ai
+
+import (
+	"errors"
+
+	"github.com/allanjose001/go-battleship/internal/entity"
+)
+
+// ShotOutcome é o que a IA fica sabendo de um tiro.
+type ShotOutcome int
+
+const (
+	ShotMiss ShotOutcome = iota // água
+	ShotHit                     // acertou um navio que continua vivo
+	ShotSunk                    // afundou um navio (o tamanho vem em SunkSize)
+)
+
+// ShotResult é uma observação da IA: o tiro e a resposta do oráculo.
+type ShotResult struct {
+	Row      int         `json:"row"`
+	Col      int         `json:"col"`
+	Outcome  ShotOutcome `json:"outcome"`
+	SunkSize int         `json:"sunk_size,omitempty"`
+}
+
+// ErrInvalidShot indica tiro fora do tabuleiro ou em célula já atacada.
+var ErrInvalidShot = errors.New("tiro inválido")
+
+// ShotOracle é tudo o que a IA enxerga do tabuleiro adversário: a dimensão,
+// as regras públicas da partida e a resposta de cada tiro (água, acerto ou
+// afundou, com o tamanho). Navios e posições continuam escondidos.
+type ShotOracle interface {
+	Size() int
+	NoTouch() bool
+	Fire(row, col int) (ShotResult, error)
+}
+
+// boardOracle responde aos tiros da IA aplicando-os em um entity.Board.
+type boardOracle struct {
+	board *entity.Board
+}
+
+// NewBoardOracle cria o oráculo de tiros sobre o tabuleiro do adversário da IA.
+func NewBoardOracle(b *entity.Board) ShotOracle {
+	return &boardOracle{board: b}
+}
+
+func (o *boardOracle) Size() int {
+	return o.board.Size
+}
+
+func (o *boardOracle) NoTouch() bool {
+	return o.board.NoTouch
+}
+
+func (o *boardOracle) Fire(row, col int) (ShotResult, error) {
+	res := ShotResult{Row: row, Col: col}
+	if !o.board.CheckPosition(row, col) {
+		return res, ErrInvalidShot
+	}
+
+	ship := o.board.AttackPositionB(row, col)
+	switch {
+	case ship == nil:
+		res.Outcome = ShotMiss
+	case ship.IsDestroyed():
+		res.Outcome = ShotSunk
+		res.SunkSize = ship.Size
+	default:
+		res.Outcome = ShotHit
+	}
+	return res, nil
+}
+
+// Fire dispara em (row, col) pelo oráculo e registra a observação na IA.
+func (ai *AIPlayer) Fire(oracle ShotOracle, row, col int) (ShotResult, error) {
+	res, err := oracle.Fire(row, col)
+	if err != nil {
+		return res, err
+	}
+	ai.noTouch = oracle.NoTouch()
+	ai.Observe(res)
+	return res, nil
+}
+
+// History devolve as observações da IA na ordem dos tiros.
+func (ai *AIPlayer) History() []ShotResult {
+	return ai.history
+}
+
+// hasUnknownCell indica se ainda há alguma célula desconhecida no tabuleiro virtual.
+func (ai *AIPlayer) hasUnknownCell() bool {
+	for i := range ai.virtualBoard {
+		for j := range ai.virtualBoard[i] {
+			if ai.virtualBoard[i][j] == 0 {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// forgetInferences volta a desconhecidas as células marcadas só por dedução
+// (sem tiro no histórico). No modo dinâmico os navios se movem e uma dedução
+// pode ficar errada; sem isso a IA poderia ficar sem alvo com navios vivos.
+// Informa se alguma célula foi reaberta.
+func (ai *AIPlayer) forgetInferences() bool {
+	shot := make(map[Pair]bool, len(ai.history))
+	for _, res := range ai.history {
+		shot[Pair{res.Row, res.Col}] = true
+	}
+
+	reopened := false
+	for i := range ai.virtualBoard {
+		for j := range ai.virtualBoard[i] {
+			if ai.virtualBoard[i][j] != 0 && !shot[Pair{i, j}] {
+				ai.virtualBoard[i][j] = 0
+				reopened = true
+			}
+		}
+	}
+	return reopened
+}
+
+// resolveSunk deduz, só pelas observações, as células do navio de tamanho size
+// afundado pelo tiro em (row, col): um segmento de acertos ainda não resolvidos
+// que passa pelo tiro. Se houver mais de um segmento possível, só o tiro é certo.
+func (ai *AIPlayer) resolveSunk(row, col, size int) []Pair {
+	var candidates [][]Pair
+	for _, horizontal := range []bool{true, false} {
+		for offset := 0; offset < size; offset++ {
+			r, c := row, col-offset
+			if !horizontal {
+				r, c = row-offset, col
+			}
+
+			var cells []Pair
+			for k := 0; k < size; k++ {
+				cr, cc := r, c+k
+				if !horizontal {
+					cr, cc = r+k, c
+				}
+				if !ai.IsValidForTesting(cr, cc) || (ai.virtualBoard[cr][cc] != 2 && !(cr == row && cc == col)) {
+					cells = nil
+					break
+				}
+				cells = append(cells, Pair{cr, cc})
+			}
+			if cells != nil {
+				candidates = append(candidates, cells)
+			}
+		}
+		if size == 1 {
+			break // navio de uma célula: horizontal e vertical são o mesmo segmento
+		}
+	}
+
+	if len(candidates) == 1 {
+		return candidates[0]
+	}
+	return []Pair{{row, col}}
+}
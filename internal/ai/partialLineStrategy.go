@@ -1,22 +1,22 @@
 package ai
 
-import "github.com/allanjose001/go-battleship/internal/entity"
-
 type PartialLineStrategy struct{}
 
-func (s *PartialLineStrategy) TryAttack(ai *AIPlayer, board *entity.Board) bool {
+func (s *PartialLineStrategy) TryAttack(ai *AIPlayer, oracle ShotOracle) bool {
 	if len(ai.priorityQueue) == 0 {
 		return false
 	}
 
 	row, col := ai.PopPriority()
-	ship := board.AttackPositionB(row, col)
 
-	// centraliza a atualização de estado
-	ai.AdjustStrategy(board, row, col, ship)
+	// Fire centraliza a atualização de estado
+	res, err := ai.Fire(oracle, row, col)
+	if err != nil {
+		return false
+	}
 
 	// se não acertou nada, não tenta linha
-	if ship == nil || ship.IsDestroyed() {
+	if res.Outcome != ShotHit {
 		return true
 	}
 
@@ -0,0 +1,37 @@
+package ai
+
+import "fmt"
+
+// PlacementPolicy é como a IA posiciona a própria frota (ver service.AIFleetService).
+type PlacementPolicy string
+
+const (
+	// PlacementRandom sorteia posições uniformemente.
+	PlacementRandom PlacementPolicy = "random"
+	// PlacementEdges prefere navios colados às bordas do tabuleiro.
+	PlacementEdges PlacementPolicy = "edges"
+	// PlacementSpread afasta os navios uns dos outros.
+	PlacementSpread PlacementPolicy = "spread"
+	// PlacementAntiHeatmap evita as células onde o adversário costuma atirar primeiro.
+	PlacementAntiHeatmap PlacementPolicy = "anti_heatmap"
+	// PlacementDecoy agrupa a frota e deixa o menor navio isolado como isca.
+	PlacementDecoy PlacementPolicy = "decoy"
+)
+
+// PlacementPolicies lista as políticas de posicionamento conhecidas.
+func PlacementPolicies() []PlacementPolicy {
+	return []PlacementPolicy{PlacementRandom, PlacementEdges, PlacementSpread, PlacementAntiHeatmap, PlacementDecoy}
+}
+
+// Validate verifica se a política existe; vazia vale como PlacementRandom.
+func (p PlacementPolicy) Validate() error {
+	if p == "" {
+		return nil
+	}
+	for _, known := range PlacementPolicies() {
+		if p == known {
+			return nil
+		}
+	}
+	return fmt.Errorf("política de posicionamento desconhecida %q", p)
+}
@@ -0,0 +1,136 @@
+package ai
+
+// ProbabilityDensityStrategy conta, a cada turno, quantos posicionamentos possíveis
+// dos navios inimigos ainda vivos cobrem cada célula desconhecida e atira na maior
+// contagem. Com acertos ainda não resolvidos entra no modo de alvo: só contam os
+// posicionamentos que passam por esses acertos, pesados por quantos deles cobrem.
+type ProbabilityDensityStrategy struct{}
+
+func (s *ProbabilityDensityStrategy) TryAttack(ai *AIPlayer, oracle ShotOracle) bool {
+	density := ai.ProbabilityDensity()
+	if density == nil {
+		return false
+	}
+
+	row, col, ok := ai.pickDensest(density)
+	if !ok {
+		return false
+	}
+
+	_, err := ai.Fire(oracle, row, col)
+	return err == nil
+}
+
+// ProbabilityDensity devolve o mapa de densidade do turno (nil sem conhecimento da frota
+// inimiga). Células já resolvidas ficam com 0.
+func (ai *AIPlayer) ProbabilityDensity() [][]int {
+	sizes := ai.enemyShips
+	if !ai.knowsFleet || len(sizes) == 0 {
+		return nil
+	}
+
+	known := ai.virtualBoard
+	density := make([][]int, ai.boardSize)
+	for i := range density {
+		density[i] = make([]int, ai.boardSize)
+	}
+
+	// modo de alvo: há acertos sem navio afundado associado
+	if ai.accumulateDensity(known, sizes, density, true) {
+		return density
+	}
+	ai.accumulateDensity(known, sizes, density, false)
+	return density
+}
+
+// accumulateDensity soma os posicionamentos de cada tamanho em sizes no mapa density
+// e informa se algum posicionamento contou. No modo de alvo (target) só valem os que
+// cobrem ao menos um acerto não resolvido, com peso igual ao número de acertos cobertos.
+func (ai *AIPlayer) accumulateDensity(known [][]int, sizes []int, density [][]int, target bool) bool {
+	counted := false
+	for _, size := range sizes {
+		for i := 0; i < ai.boardSize; i++ {
+			for j := 0; j < ai.boardSize; j++ {
+				for _, horizontal := range []bool{true, false} {
+					weight, ok := placementWeight(known, size, i, j, horizontal, target)
+					if !ok {
+						continue
+					}
+					counted = true
+					for k := 0; k < size; k++ {
+						r, c := i, j+k
+						if !horizontal {
+							r, c = i+k, j
+						}
+						if known[r][c] == 0 {
+							density[r][c] += weight
+						}
+					}
+				}
+			}
+		}
+	}
+	return counted
+}
+
+// placementWeight avalia um navio de tamanho size com início em (row, col).
+// Fora do modo de alvo o navio só pode cobrir células desconhecidas (peso 1);
+// no modo de alvo pode cobrir acertos e precisa cobrir ao menos um.
+func placementWeight(known [][]int, size, row, col int, horizontal, target bool) (int, bool) {
+	n := len(known)
+	if horizontal && col+size > n || !horizontal && row+size > n {
+		return 0, false
+	}
+
+	hits := 0
+	for k := 0; k < size; k++ {
+		r, c := row, col+k
+		if !horizontal {
+			r, c = row+k, col
+		}
+		switch known[r][c] {
+		case 0:
+		case 2:
+			if !target {
+				return 0, false
+			}
+			hits++
+		default:
+			return 0, false
+		}
+	}
+
+	if !target {
+		return 1, true
+	}
+	if hits == 0 || hits == size {
+		return 0, false
+	}
+	return hits, true
+}
+
+// pickDensest escolhe a célula de maior densidade; empates são sorteados
+// com o gerador da partida.
+func (ai *AIPlayer) pickDensest(density [][]int) (row, col int, ok bool) {
+	best := 0
+	var ties []Pair
+	for i := range density {
+		for j, d := range density[i] {
+			if d == 0 || !ai.IsValid(i, j) {
+				continue
+			}
+			if d > best {
+				best = d
+				ties = ties[:0]
+			}
+			if d == best {
+				ties = append(ties, Pair{i, j})
+			}
+		}
+	}
+	if len(ties) == 0 {
+		return -1, -1, false
+	}
+	p := ties[ai.rng.Intn(len(ties))]
+	return p.row, p.col, true
+}
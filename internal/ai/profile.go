@@ -0,0 +1,201 @@
+package ai
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/allanjose001/go-battleship/internal/entity"
+)
+
+// DefaultThinkDelay é a pausa padrão antes da jogada da IA.
+const DefaultThinkDelay = 500 * time.Millisecond
+
+// StrategySpec é uma estratégia da pilha de um perfil: o nome no registro
+// (ver RegisterStrategy) e os parâmetros numéricos que ela aceita.
+type StrategySpec struct {
+	Name   string             `json:"name"`
+	Params map[string]float64 `json:"params,omitempty"`
+}
+
+// Param devolve o parâmetro name ou def se ele não foi informado.
+func (s StrategySpec) Param(name string, def float64) float64 {
+	if v, ok := s.Params[name]; ok {
+		return v
+	}
+	return def
+}
+
+// Profile é uma personalidade de IA: a pilha de estratégias em ordem de
+// prioridade e os ajustes de comportamento. Os perfis embutidos reproduzem as
+// dificuldades do jogo; outros podem ser carregados de JSON (ver ParseProfile).
+type Profile struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+
+	// KnowsFleet indica se a IA conhece a composição da frota inimiga (médio em diante).
+	KnowsFleet bool           `json:"knows_fleet"`
+	Strategies []StrategySpec `json:"strategies"`
+
+	// ThinkDelayMs é a pausa antes de cada jogada, em milissegundos (0 usa DefaultThinkDelay).
+	ThinkDelayMs int `json:"think_delay_ms,omitempty"`
+	// MoveChance é a chance padrão (0 a 100) de random_move mover um navio no turno
+	// (0 usa o padrão de RandomMoveStrategy).
+	MoveChance int `json:"move_chance,omitempty"`
+	// SalvoSpread espalha os tiros de caça no modo Salvo (ver planSalvo).
+	SalvoSpread bool `json:"salvo_spread,omitempty"`
+	// Placement é a política de posicionamento da frota da IA (vazia = aleatória).
+	Placement PlacementPolicy `json:"placement,omitempty"`
+}
+
+// Perfis embutidos, um por dificuldade.
+var (
+	EasyProfile = Profile{
+		ID:   "easy",
+		Name: "Recruta",
+		Strategies: []StrategySpec{
+			{Name: "random"},
+		},
+		Placement: PlacementRandom,
+	}
+
+	MediumProfile = Profile{
+		ID:         "medium",
+		Name:       "Imediato",
+		KnowsFleet: true,
+		Strategies: []StrategySpec{
+			{Name: "partial_line"},
+			{Name: "discovery"},
+			{Name: "random"},
+		},
+		Placement: PlacementEdges,
+	}
+
+	HardProfile = Profile{
+		ID:         "hard",
+		Name:       "Almirante",
+		KnowsFleet: true,
+		Strategies: []StrategySpec{
+			{Name: "strategic_search"},
+			{Name: "full_line"},
+			{Name: "discovery"},
+			{Name: "habits"},
+			{Name: "random"},
+		},
+		SalvoSpread: true,
+		Placement:   PlacementSpread,
+	}
+
+	// ExpertProfile mira pela densidade de probabilidade dos navios restantes,
+	// pesada na caça pelos hábitos do jogador; a estratégia aleatória só entra
+	// se a densidade não achar alvo.
+	ExpertProfile = Profile{
+		ID:         "expert",
+		Name:       "Lenda",
+		KnowsFleet: true,
+		Strategies: []StrategySpec{
+			{Name: "habits"},
+			{Name: "probability_density"},
+			{Name: "random"},
+		},
+		SalvoSpread: true,
+		Placement:   PlacementAntiHeatmap,
+	}
+
+	// DynamicProfile é a IA do modo dinâmico: tira da linha de tiro os navios
+	// ameaçados (ver EvasionStrategy) e, quando não compensa mover, ataca.
+	DynamicProfile = Profile{
+		ID:         "dynamic",
+		Name:       "Dinâmica",
+		KnowsFleet: true,
+		Strategies: []StrategySpec{
+			{Name: "evasion"},
+			{Name: "strategic_search"},
+			{Name: "full_line"},
+			{Name: "discovery"},
+			{Name: "random"},
+		},
+		Placement: PlacementSpread,
+	}
+)
+
+// BuiltinProfiles devolve os perfis embutidos na ordem das dificuldades.
+func BuiltinProfiles() []Profile {
+	return []Profile{EasyProfile, MediumProfile, HardProfile, ExpertProfile, DynamicProfile}
+}
+
+// ThinkDelay devolve a pausa antes de cada jogada da IA.
+func (p Profile) ThinkDelay() time.Duration {
+	if p.ThinkDelayMs <= 0 {
+		return DefaultThinkDelay
+	}
+	return time.Duration(p.ThinkDelayMs) * time.Millisecond
+}
+
+// Validate verifica se o perfil tem id e se todas as estratégias existem no registro.
+func (p Profile) Validate() error {
+	if p.ID == "" {
+		return errors.New("perfil de IA sem id")
+	}
+	if len(p.Strategies) == 0 {
+		return fmt.Errorf("perfil %q sem estratégias", p.ID)
+	}
+	for _, spec := range p.Strategies {
+		if _, err := NewStrategy(spec, p); err != nil {
+			return fmt.Errorf("perfil %q: %w", p.ID, err)
+		}
+	}
+	if err := p.Placement.Validate(); err != nil {
+		return fmt.Errorf("perfil %q: %w", p.ID, err)
+	}
+	return nil
+}
+
+// Build cria a IA do perfil contra enemyFleet. ownBoard é o tabuleiro da
+// própria IA, necessário só para as estratégias que movem navios (pode ser nil).
+func (p Profile) Build(enemyFleet *entity.Fleet, boardSize int, ownBoard *entity.Board) (*AIPlayer, error) {
+	strategies := make([]Strategy, 0, len(p.Strategies))
+	for _, spec := range p.Strategies {
+		strat, err := NewStrategy(spec, p)
+		if err != nil {
+			return nil, fmt.Errorf("perfil %q: %w", p.ID, err)
+		}
+		strategies = append(strategies, strat)
+	}
+
+	if !p.KnowsFleet {
+		enemyFleet = nil
+	}
+	ai := newAIPlayer(boardSize, enemyFleet, strategies...)
+	ai.salvoSpread = p.SalvoSpread
+	if ownBoard != nil {
+		ai.ownBoard = ownBoard
+		ai.evasionQueue = make([]*entity.Ship, 0)
+	}
+	return ai, nil
+}
+
+// mustBuild cria a IA de um perfil embutido, que sempre é válido.
+func (p Profile) mustBuild(enemyFleet *entity.Fleet, boardSize int, ownBoard *entity.Board) *AIPlayer {
+	ai, err := p.Build(enemyFleet, boardSize, ownBoard)
+	if err != nil {
+		panic(err)
+	}
+	return ai
+}
+
+// ParseProfile decodifica um perfil em JSON e o valida.
+func ParseProfile(data []byte) (Profile, error) {
+	var p Profile
+	if err := json.Unmarshal(data, &p); err != nil {
+		return Profile{}, err
+	}
+	if p.Name == "" {
+		p.Name = p.ID
+	}
+	if err := p.Validate(); err != nil {
+		return Profile{}, err
+	}
+	return p, nil
+}
@@ -2,23 +2,20 @@ package ai
 
 import (
 	"fmt"
-	"math/rand"
 
 	"github.com/allanjose001/go-battleship/internal/entity"
 )
 
 // RandomMoveStrategy move aleatoriamente um navio da IA com uma certa probabilidade,
-// sem depender de ter sido atacado. Usada no modo dinâmico.
+// sem depender de ter sido atacado. Disponível para perfis do modo dinâmico que
+// devem mover às cegas (a IA Dinâmica usa EvasionStrategy).
 type RandomMoveStrategy struct {
 	// Chance de 0 a 100 de tentar mover um navio por turno (ex: 40 = 40%)
 	Chance int
 }
 
-func (s *RandomMoveStrategy) TryAttack(ai *AIPlayer, board *entity.Board) bool {
-	fmt.Println("chegou em randomMoveStrategy")
-
+func (s *RandomMoveStrategy) TryAttack(ai *AIPlayer, oracle ShotOracle) bool {
 	if ai.ownBoard == nil {
-		fmt.Println("randomMoveStrategy: ownBoard nil, pulando")
 		return false
 	}
 
@@ -27,20 +24,18 @@ func (s *RandomMoveStrategy) TryAttack(ai *AIPlayer, board *entity.Board) bool {
 	if chance <= 0 {
 		chance = 15 // padrão: 40%
 	}
-	if rand.Intn(100) >= chance {
-		fmt.Println("randomMoveStrategy: não ativou neste turno (sorte)")
+	if ai.rng.Intn(100) >= chance {
 		return false
 	}
 
 	// Coleta todos os navios ainda vivos no próprio board da IA
 	aliveShips := collectAliveShips(ai.ownBoard)
 	if len(aliveShips) == 0 {
-		fmt.Println("randomMoveStrategy: nenhum navio vivo encontrado")
 		return false
 	}
 
 	// Embaralha para escolher um navio aleatório
-	rand.Shuffle(len(aliveShips), func(i, j int) {
+	ai.rng.Shuffle(len(aliveShips), func(i, j int) {
 		aliveShips[i], aliveShips[j] = aliveShips[j], aliveShips[i]
 	})
 
@@ -54,7 +49,7 @@ func (s *RandomMoveStrategy) TryAttack(ai *AIPlayer, board *entity.Board) bool {
 		}
 
 		// Embaralha direções para evitar viés
-		rand.Shuffle(len(dirs), func(i, j int) { dirs[i], dirs[j] = dirs[j], dirs[i] })
+		ai.rng.Shuffle(len(dirs), func(i, j int) { dirs[i], dirs[j] = dirs[j], dirs[i] })
 
 		for _, dir := range dirs {
 			dr, dc := dirToDeltas(dir)
@@ -62,14 +57,12 @@ func (s *RandomMoveStrategy) TryAttack(ai *AIPlayer, board *entity.Board) bool {
 			newCol := topC + dc
 
 			if err := ai.ownBoard.MoveShip(ship, newRow, newCol); err == nil {
-				fmt.Printf("randomMoveStrategy: '%s' movido de (%d,%d) para (%d,%d)\n",
-					ship.Name, topR, topC, newRow, newCol)
+				ai.lastNote = fmt.Sprintf("'%s' movido de (%d,%d) para (%d,%d)", ship.Name, topR, topC, newRow, newCol)
 				return true // consumiu o turno: IA moveu, não ataca
 			}
 		}
 	}
 
-	fmt.Println("randomMoveStrategy: nenhum navio pôde ser movido")
 	return false
 }
 
@@ -78,8 +71,8 @@ func collectAliveShips(b *entity.Board) []*entity.Ship {
 	seen := make(map[*entity.Ship]bool)
 	var result []*entity.Ship
 
-	for r := 0; r < entity.BoardSize; r++ {
-		for c := 0; c < entity.BoardSize; c++ {
+	for r := 0; r < b.Size; r++ {
+		for c := 0; c < b.Size; c++ {
 			ship := entity.GetShipReference(b.Positions[r][c])
 			if ship != nil && !ship.IsDestroyed() && !seen[ship] {
 				seen[ship] = true
@@ -1,26 +1,25 @@
 package ai
 
-import (
-	"fmt"
-	"github.com/allanjose001/go-battleship/internal/entity"
-	"math/rand"
-)
-
 type RandomStrategy struct{}
 
-const boardSize = 10
-
-func (s *RandomStrategy) TryAttack(ai *AIPlayer, board *entity.Board) bool {
-
-	fmt.Println("randomStrategy usada")
+func (s *RandomStrategy) TryAttack(ai *AIPlayer, oracle ShotOracle) bool {
+	if !ai.hasUnknownCell() && !ai.forgetInferences() {
+		return false
+	}
 
 	for {
-		row := rand.Intn(boardSize)
-		col := rand.Intn(boardSize)
+		row := ai.rng.Intn(ai.boardSize)
+		col := ai.rng.Intn(ai.boardSize)
 
 		if ai.IsValid(row, col) {
-			ship := board.AttackPositionB(row, col)
-			ai.AdjustStrategy(board, row, col, ship)
+			if _, err := ai.Fire(oracle, row, col); err != nil {
+				// célula já atacada sem a IA saber: não tenta de novo
+				ai.virtualBoard[row][col] = 1
+				if !ai.hasUnknownCell() {
+					return false
+				}
+				continue
+			}
 			return true
 		}
 	}
@@ -0,0 +1,104 @@
+package ai
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+)
+
+// StrategyFactory cria uma estratégia a partir da sua entrada no perfil.
+// O perfil inteiro é passado para parâmetros com padrão no nível do perfil
+// (ex.: MoveChance).
+type StrategyFactory func(spec StrategySpec, p Profile) (Strategy, error)
+
+var (
+	strategyRegistryMu sync.RWMutex
+	strategyRegistry   = map[string]StrategyFactory{
+		"random": func(StrategySpec, Profile) (Strategy, error) {
+			return &RandomStrategy{}, nil
+		},
+		"partial_line": func(StrategySpec, Profile) (Strategy, error) {
+			return &PartialLineStrategy{}, nil
+		},
+		"full_line": func(StrategySpec, Profile) (Strategy, error) {
+			return &FullLineStrategy{}, nil
+		},
+		"discovery": func(StrategySpec, Profile) (Strategy, error) {
+			return &DiscoveryStrategy{}, nil
+		},
+		"strategic_search": func(spec StrategySpec, _ Profile) (Strategy, error) {
+			threshold := spec.Param("threshold", DefaultStrategicThreshold)
+			if threshold < 0 || threshold > 1 {
+				return nil, fmt.Errorf("strategic_search: threshold %v fora de [0, 1]", threshold)
+			}
+			return &StrategicSearchStrategy{Threshold: threshold}, nil
+		},
+		"probability_density": func(StrategySpec, Profile) (Strategy, error) {
+			return &ProbabilityDensityStrategy{}, nil
+		},
+		"random_move": func(spec StrategySpec, p Profile) (Strategy, error) {
+			chance := int(spec.Param("chance", float64(p.MoveChance)))
+			if chance < 0 || chance > 100 {
+				return nil, fmt.Errorf("random_move: chance %d fora de [0, 100]", chance)
+			}
+			return &RandomMoveStrategy{Chance: chance}, nil
+		},
+		"habits": func(spec StrategySpec, _ Profile) (Strategy, error) {
+			weight := spec.Param("weight", DefaultHabitWeight)
+			if weight < 0 {
+				return nil, fmt.Errorf("habits: weight %v negativo", weight)
+			}
+			return &HabitStrategy{Weight: weight}, nil
+		},
+		"blunder": func(spec StrategySpec, _ Profile) (Strategy, error) {
+			chance := spec.Param("chance", 0)
+			if chance < 0 || chance > 1 {
+				return nil, fmt.Errorf("blunder: chance %v fora de [0, 1]", chance)
+			}
+			return &BlunderStrategy{Chance: chance}, nil
+		},
+		"evasion": func(spec StrategySpec, _ Profile) (Strategy, error) {
+			threshold := spec.Param("threshold", DefaultEvasionThreshold)
+			if threshold < 0 {
+				return nil, fmt.Errorf("evasion: threshold %v negativo", threshold)
+			}
+			cooldown := int(spec.Param("cooldown", DefaultEvasionCooldown))
+			if cooldown < 0 {
+				return nil, fmt.Errorf("evasion: cooldown %d negativo", cooldown)
+			}
+			return &EvasionStrategy{Threshold: threshold, Cooldown: cooldown}, nil
+		},
+	}
+)
+
+// RegisterStrategy disponibiliza uma estratégia para os perfis pelo nome.
+// Registrar um nome existente substitui a fábrica anterior.
+func RegisterStrategy(name string, factory StrategyFactory) {
+	strategyRegistryMu.Lock()
+	defer strategyRegistryMu.Unlock()
+	strategyRegistry[name] = factory
+}
+
+// NewStrategy resolve spec no registro de estratégias.
+func NewStrategy(spec StrategySpec, p Profile) (Strategy, error) {
+	strategyRegistryMu.RLock()
+	factory, ok := strategyRegistry[spec.Name]
+	strategyRegistryMu.RUnlock()
+	if !ok {
+		return nil, fmt.Errorf("estratégia desconhecida %q", spec.Name)
+	}
+	return factory(spec, p)
+}
+
+// StrategyNames lista as estratégias registradas em ordem alfabética.
+func StrategyNames() []string {
+	strategyRegistryMu.RLock()
+	defer strategyRegistryMu.RUnlock()
+
+	names := make([]string, 0, len(strategyRegistry))
+	for name := range strategyRegistry {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
@@ -0,0 +1,138 @@
+package ai
+
+import (
+	"fmt"
+
+	"github.com/allanjose001/go-battleship/internal/entity"
+)
+
+// FireSalvo dispara uma salva de n tiros (modo Salvo) e devolve as células atingidas,
+// na ordem dos disparos. Todos os alvos são escolhidos antes de qualquer resultado:
+// a IA só ajusta a estratégia depois que a salva inteira foi revelada.
+func (ai *AIPlayer) FireSalvo(oracle ShotOracle, n int) []entity.Cell {
+	targets := ai.planSalvo(n)
+
+	fired := make([]entity.Cell, 0, len(targets))
+	results := make([]ShotResult, 0, len(targets))
+	for _, t := range targets {
+		res, err := oracle.Fire(t.Row, t.Col)
+		if err != nil {
+			continue
+		}
+		fired = append(fired, t)
+		results = append(results, res)
+	}
+
+	ai.noTouch = oracle.NoTouch()
+	ai.lastStrategy = "Salvo"
+	ai.lastNote = fmt.Sprintf("salva de %d tiros", len(fired))
+	for _, res := range results {
+		ai.Observe(res)
+	}
+	return fired
+}
+
+// planSalvo escolhe até n alvos distintos ainda não atacados.
+// Coordenação da salva:
+// 1. alvos da fila de prioridade (vizinhos de acertos ainda não afundados);
+// 2. células de paridade pelo menor navio vivo (xadrez); na IA difícil cada
+// uma o mais distante possível das já escolhidas, para a salva cobrir o tabuleiro.
+// A IA fácil (sem conhecimento da frota) escolhe alvos aleatórios.
+func (ai *AIPlayer) planSalvo(n int) []entity.Cell {
+	chosen := make([]entity.Cell, 0, n)
+	taken := make(map[entity.Cell]bool)
+
+	free := func(row, col int) bool {
+		c := entity.Cell{Row: row, Col: col}
+		return ai.IsValid(row, col) && !taken[c]
+	}
+	take := func(row, col int) {
+		c := entity.Cell{Row: row, Col: col}
+		taken[c] = true
+		chosen = append(chosen, c)
+	}
+
+	if ai.knowsFleet {
+		for len(chosen) < n && len(ai.priorityQueue) > 0 {
+			row, col := ai.PopPriority()
+			if free(row, col) {
+				take(row, col)
+			}
+		}
+	}
+
+	var candidates []entity.Cell
+	var fallback []entity.Cell
+	parity := ai.smallestAliveShip()
+	for i := 0; i < ai.boardSize; i++ {
+		for j := 0; j < ai.boardSize; j++ {
+			if !free(i, j) {
+				continue
+			}
+			fallback = append(fallback, entity.Cell{Row: i, Col: j})
+			if parity > 1 && (i+j)%parity == 0 {
+				candidates = append(candidates, entity.Cell{Row: i, Col: j})
+			}
+		}
+	}
+	if len(candidates) == 0 {
+		candidates = fallback
+	}
+	ai.rng.Shuffle(len(candidates), func(i, j int) { candidates[i], candidates[j] = candidates[j], candidates[i] })
+
+	for len(chosen) < n && len(candidates) > 0 {
+		best := 0
+		if ai.salvoSpread && len(chosen) > 0 {
+			bestDist := -1
+			for i, c := range candidates {
+				if d := minDistance(c, chosen); d > bestDist {
+					best, bestDist = i, d
+				}
+			}
+		}
+
+		c := candidates[best]
+		candidates = append(candidates[:best], candidates[best+1:]...)
+		take(c.Row, c.Col)
+
+		// acabaram as células de paridade: completa com as demais livres
+		if len(candidates) == 0 && len(chosen) < n {
+			for _, f := range fallback {
+				if !taken[f] {
+					candidates = append(candidates, f)
+				}
+			}
+		}
+	}
+	return chosen
+}
+
+// smallestAliveShip devolve o tamanho do menor navio inimigo ainda vivo (0 se desconhecido).
+func (ai *AIPlayer) smallestAliveShip() int {
+	smallest := 0
+	for _, size := range ai.enemyShips {
+		if smallest == 0 || size < smallest {
+			smallest = size
+		}
+	}
+	return smallest
+}
+
+// minDistance devolve a menor distância de Manhattan entre c e as células escolhidas.
+func minDistance(c entity.Cell, chosen []entity.Cell) int {
+	best := -1
+	for _, o := range chosen {
+		d := abs(c.Row-o.Row) + abs(c.Col-o.Col)
+		if best == -1 || d < best {
+			best = d
+		}
+	}
+	return best
+}
+
+func abs(x int) int {
+	if x < 0 {
+		return -x
+	}
+	return x
+}
@@ -0,0 +1,100 @@
+package ai
+
+import (
+	"fmt"
+
+	"github.com/allanjose001/go-battleship/internal/entity"
+)
+
+// State é o estado interno serializável da IA, usado para salvar e retomar
+// uma partida em andamento. As estratégias não guardam estado próprio, então
+// basta recriar a IA pela dificuldade e aplicar o State.
+type State struct {
+	VirtualBoard  [][]int  `json:"virtual_board"`
+	PriorityQueue [][2]int `json:"priority_queue,omitempty"`
+	ChaseMode     bool     `json:"chase_mode,omitempty"`
+	// EvasionQueue guarda os índices dos navios na frota própria da IA
+	EvasionQueue []int `json:"evasion_queue,omitempty"`
+	// IncomingHits são os acertos do adversário na frota própria: linha, coluna e
+	// índice do navio atingido
+	IncomingHits [][3]int `json:"incoming_hits,omitempty"`
+	// EvasionRest são os turnos de ataque que faltam antes de poder mover de novo
+	EvasionRest int `json:"evasion_rest,omitempty"`
+	// EnemyShips são os tamanhos dos navios inimigos que a IA ainda não viu afundar
+	EnemyShips []int        `json:"enemy_ships,omitempty"`
+	History    []ShotResult `json:"history,omitempty"`
+	NoTouch    bool         `json:"no_touch,omitempty"`
+}
+
+// State copia o estado interno da IA. ownFleet é a frota da própria IA,
+// usada para traduzir a fila de evasão em índices.
+func (ai *AIPlayer) State(ownFleet *entity.Fleet) State {
+	st := State{
+		VirtualBoard: make([][]int, len(ai.virtualBoard)),
+		ChaseMode:    ai.chaseMode,
+		EnemyShips:   append([]int(nil), ai.enemyShips...),
+		History:      append([]ShotResult(nil), ai.history...),
+		NoTouch:      ai.noTouch,
+		EvasionRest:  ai.evasionRest,
+	}
+	for i, row := range ai.virtualBoard {
+		st.VirtualBoard[i] = append([]int(nil), row...)
+	}
+	for _, p := range ai.priorityQueue {
+		st.PriorityQueue = append(st.PriorityQueue, [2]int{p.row, p.col})
+	}
+	for _, ship := range ai.evasionQueue {
+		if idx := entity.FleetShipIndex(ownFleet, ship); idx >= 0 {
+			st.EvasionQueue = append(st.EvasionQueue, idx)
+		}
+	}
+	for _, h := range ai.incomingHits {
+		if idx := entity.FleetShipIndex(ownFleet, h.ship); idx >= 0 {
+			st.IncomingHits = append(st.IncomingHits, [3]int{h.cell.Row, h.cell.Col, idx})
+		}
+	}
+	return st
+}
+
+// RestoreState aplica um State salvo sobre uma IA recém-criada para a mesma partida.
+func (ai *AIPlayer) RestoreState(st State, ownFleet *entity.Fleet) error {
+	if len(st.VirtualBoard) != ai.boardSize {
+		return fmt.Errorf("tabuleiro virtual com %d linhas, esperado %d", len(st.VirtualBoard), ai.boardSize)
+	}
+	for i, row := range st.VirtualBoard {
+		if len(row) != ai.boardSize {
+			return fmt.Errorf("linha %d do tabuleiro virtual com %d colunas, esperado %d", i, len(row), ai.boardSize)
+		}
+		copy(ai.virtualBoard[i], row)
+	}
+
+	ai.priorityQueue = nil
+	for _, p := range st.PriorityQueue {
+		ai.priorityQueue = append(ai.priorityQueue, Pair{p[0], p[1]})
+	}
+	ai.chaseMode = st.ChaseMode
+	// saves anteriores ao oráculo não trazem os navios restantes: fica a frota inteira
+	if st.EnemyShips != nil {
+		ai.enemyShips = append([]int(nil), st.EnemyShips...)
+	}
+	ai.history = append([]ShotResult(nil), st.History...)
+	ai.noTouch = st.NoTouch
+
+	ai.evasionQueue = ai.evasionQueue[:0]
+	for _, idx := range st.EvasionQueue {
+		if ownFleet != nil && idx >= 0 && idx < len(ownFleet.Ships) {
+			ai.evasionQueue = append(ai.evasionQueue, ownFleet.Ships[idx])
+		}
+	}
+	ai.incomingHits = nil
+	for _, h := range st.IncomingHits {
+		if ownFleet != nil && h[2] >= 0 && h[2] < len(ownFleet.Ships) {
+			ai.incomingHits = append(ai.incomingHits, incomingHit{
+				cell: entity.Cell{Row: h[0], Col: h[1]},
+				ship: ownFleet.Ships[h[2]],
+			})
+		}
+	}
+	ai.evasionRest = st.EvasionRest
+	return nil
+}
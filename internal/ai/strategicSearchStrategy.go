@@ -1,19 +1,21 @@
 package ai
 
-import "github.com/allanjose001/go-battleship/internal/entity"
-import "fmt"
+// DefaultStrategicThreshold é a fração do tabuleiro já conhecida a partir da
+// qual a busca estratégica entra em ação.
+const DefaultStrategicThreshold = 0.3
 
-type StrategicSearchStrategy struct{}
+type StrategicSearchStrategy struct {
+	// Threshold é a fração de 0 a 1 do tabuleiro conhecida para a busca agir
+	// (0 age desde o primeiro tiro; o perfil sem o parâmetro usa DefaultStrategicThreshold).
+	Threshold float64
+}
 
-func (s *StrategicSearchStrategy) TryAttack(ai *AIPlayer, board *entity.Board) bool {
-	fmt.Println("chegou em StrategicShearch")
+func (s *StrategicSearchStrategy) TryAttack(ai *AIPlayer, oracle ShotOracle) bool {
 	if len(ai.priorityQueue) != 0 {
-		fmt.Println("SSS: skipping because priorityQueue not empty")
 		return false
 	}
 
-	should := ai.ShouldAttackStrategicPositions()
-	fmt.Printf("SSS: ShouldAttackStrategicPositions=%v\n", should)
+	should := ai.ShouldAttackStrategicPositions(s.Threshold)
 	if !should {
 		return false
 	}
@@ -21,9 +23,7 @@ func (s *StrategicSearchStrategy) TryAttack(ai *AIPlayer, board *entity.Board) b
 	//    return false
 	//}
 	size := ai.SizeOfNextShip()
-	fmt.Printf("SSS: SizeOfNextShip=%d\n", size)
 	if size == 0 {
-		fmt.Println("SSS: no ships left according to AI enemyFleet")
 		return false
 	}
 	// tenta vertical primeiro ou horizontal aleatoriamente
@@ -1,7 +1,8 @@
 package ai
 
-import "github.com/allanjose001/go-battleship/internal/entity"
-
+// Strategy é uma ação possível da IA no turno; TryAttack devolve true se agiu.
+// O tabuleiro adversário só é visto pelo oráculo de tiros (ShotOracle), então
+// qualquer estratégia, inclusive de terceiros, joga só com o que observou.
 type Strategy interface {
-	TryAttack(ai *AIPlayer, board *entity.Board) bool
+	TryAttack(ai *AIPlayer, oracle ShotOracle) bool
 }
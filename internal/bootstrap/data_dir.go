@@ -0,0 +1,132 @@
+package bootstrap
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+)
+
+// DataDirEnvVar é a variável de ambiente que troca o diretório de dados do
+// jogo (perfis, partidas salvas e replays). A flag --data-dir tem prioridade.
+const DataDirEnvVar = "BATTLESHIP_DATA_DIR"
+
+// appDirName é o nome do diretório do jogo dentro do diretório de dados do usuário.
+const appDirName = "go-battleship"
+
+// legacyProfilesFile é o arquivo cuja presença marca que um diretório de
+// dados já está em uso (e que a migração do diretório antigo já foi feita).
+const legacyProfilesFile = "profiles.json"
+
+// ResolveDataDir devolve o diretório de dados do jogo: flagDir se informado,
+// senão $BATTLESHIP_DATA_DIR, senão o diretório de dados do usuário no
+// sistema (ver userDataDir). O caminho devolvido é absoluto.
+func ResolveDataDir(flagDir string) (string, error) {
+	dir := flagDir
+	if dir == "" {
+		dir = os.Getenv(DataDirEnvVar)
+	}
+	if dir == "" {
+		base, err := userDataDir()
+		if err != nil {
+			return "", fmt.Errorf("diretório de dados do usuário: %w (use --data-dir ou %s)", err, DataDirEnvVar)
+		}
+		dir = filepath.Join(base, appDirName)
+	}
+	return filepath.Abs(dir)
+}
+
+// userDataDir é onde o sistema guarda dados de aplicativos do usuário:
+// $XDG_DATA_HOME (ou ~/.local/share) no Linux e demais Unix, %AppData% no
+// Windows e ~/Library/Application Support no macOS.
+func userDataDir() (string, error) {
+	switch runtime.GOOS {
+	case "windows", "darwin", "ios", "plan9":
+		return os.UserConfigDir()
+	}
+
+	if dir := os.Getenv("XDG_DATA_HOME"); filepath.IsAbs(dir) {
+		return dir, nil
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(home, ".local", "share"), nil
+}
+
+// MigrateLegacyData copia os dados do diretório antigo, relativo à pasta de
+// onde o jogo era executado (internal/data), para dir. Só acontece uma vez:
+// se dir já tem profiles.json, ou se não há profiles.json em legacyDir, nada
+// é feito. Os replays e as partidas salvas vão junto; o profiles.json é
+// copiado por último, então uma migração interrompida é refeita por inteiro
+// na próxima execução. O diretório antigo não é alterado.
+func MigrateLegacyData(legacyDir, dir string) (bool, error) {
+	legacyAbs, err := filepath.Abs(legacyDir)
+	if err != nil {
+		return false, err
+	}
+	if dir, err = filepath.Abs(dir); err != nil || dir == legacyAbs {
+		return false, err
+	}
+
+	target := filepath.Join(dir, legacyProfilesFile)
+	if _, err := os.Stat(target); err == nil || !os.IsNotExist(err) {
+		return false, err
+	}
+	source := filepath.Join(legacyAbs, legacyProfilesFile)
+	if _, err := os.Stat(source); err != nil {
+		if os.IsNotExist(err) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	for _, sub := range []string{"replays", "saves"} {
+		if err := copyDir(filepath.Join(legacyAbs, sub), filepath.Join(dir, sub)); err != nil {
+			return false, fmt.Errorf("migrando %s: %w", sub, err)
+		}
+	}
+	if err := copyFile(source, target); err != nil {
+		return false, fmt.Errorf("migrando %s: %w", legacyProfilesFile, err)
+	}
+	return true, nil
+}
+
+// copyDir copia os arquivos (não recursivo) de src para dst; src ausente não é erro.
+func copyDir(src, dst string) error {
+	entries, err := os.ReadDir(src)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil
+		}
+		return err
+	}
+	for _, e := range entries {
+		if !e.Type().IsRegular() {
+			continue
+		}
+		if err := copyFile(filepath.Join(src, e.Name()), filepath.Join(dst, e.Name())); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// copyFile copia src para dst, criando o diretório de dst. O conteúdo vai
+// para um temporário renomeado no fim, para dst nunca ficar pela metade.
+func copyFile(src, dst string) error {
+	data, err := os.ReadFile(src)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(dst), 0755); err != nil {
+		return err
+	}
+	tmp := dst + ".tmp"
+	if err := os.WriteFile(tmp, data, 0644); err != nil {
+		return err
+	}
+	return os.Rename(tmp, dst)
+}
@@ -5,9 +5,9 @@ import (
 	"time"
 )
 
-// InitRandom inicializa a seed global de rand
-// para toda a aplicação, evitando chamadas repetidas
-// em cada service individual.
+// InitRandom inicializa a seed global de rand.
+// Só efeitos cosméticos (ex.: variação de SFX) usam o gerador global;
+// a aleatoriedade das partidas vem de entity.Match.Rand (reproduzível pela seed).
 func InitRandom() {
 	rand.Seed(time.Now().UnixNano())
 }
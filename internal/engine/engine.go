@@ -0,0 +1,218 @@
+// Package engine expõe as regras do Battleship sem nenhuma dependência de
+// Ebiten ou de UI: partida, tabuleiros, frotas, turnos e pontuação.
+// É a API pensada para ser dirigida por qualquer cliente (o jogo Ebiten,
+// ferramentas de linha de comando, bots e testes).
+package engine
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/allanjose001/go-battleship/internal/ai"
+	"github.com/allanjose001/go-battleship/internal/entity"
+	"github.com/allanjose001/go-battleship/internal/service"
+)
+
+var (
+	// ErrNotDynamic indica tentativa de mover navio fora do modo dinâmico.
+	ErrNotDynamic = errors.New("match is not in dynamic mode")
+)
+
+// Config descreve uma partida headless.
+type Config struct {
+	ID         string
+	Difficulty string           // id do perfil de IA: "easy", "medium", "hard", "expert", "adaptive" ou um de assets/ai_profiles
+	BoardSize  int              // dimensão do tabuleiro; fora dos limites usa o padrão
+	FleetSpec  entity.FleetSpec // composição das frotas; vazia ou grande demais usa a padrão
+	Dynamic    bool             // modo dinâmico (jogador move navios, IA com evasão)
+	Salvo      bool             // modo Salvo (um tiro por navio vivo por turno); ignorado no modo dinâmico
+	NoTouch    bool             // navios não se encostam, nem na diagonal (desligada se a frota não couber)
+	Seed       int64            // semente da partida; 0 sorteia pelo relógio (ver Match.Seed)
+
+	// AIDelay é o intervalo entre ataques da IA (<= 0 usa o padrão do MatchService).
+	// Em modo headless o relógio é o `now` passado pelo chamador, então não há espera real.
+	AIDelay time.Duration
+
+	// Sound é opcional; nil roda sem áudio.
+	Sound service.SoundPlayer
+}
+
+// Game é uma partida em andamento: o Match (estado lógico) mais a IA adversária.
+type Game struct {
+	match    *entity.Match
+	matchSvc *service.MatchService
+	dynSvc   *service.DynamicMatchService
+	enemy    *ai.AIPlayer
+}
+
+// New cria e inicia uma partida com as duas frotas posicionadas aleatoriamente.
+// Com a mesma Config (incluindo Seed) e as mesmas jogadas, a partida se repete igual.
+func New(cfg Config, now time.Time) (*Game, error) {
+	if cfg.Seed == 0 {
+		cfg.Seed = now.UnixNano()
+	}
+
+	size := entity.NormalizeBoardSize(cfg.BoardSize)
+	spec := service.ResolveFleetSpec(cfg.FleetSpec, size)
+	noTouch := service.ResolveNoTouch(cfg.NoTouch, spec, size)
+	placer := service.NewAIFleetService(service.NewPlacementRand(cfg.Seed))
+
+	playerBoard, playerFleet := entity.NewBoard(size), entity.NewFleetFromSpec(spec)
+	playerBoard.NoTouch = noTouch
+	placer.PositionShipsRandomly(playerBoard, playerFleet)
+
+	enemyBoard, enemyFleet := entity.NewBoard(size), entity.NewFleetFromSpec(spec)
+	enemyBoard.NoTouch = noTouch
+	aiProfile := cfg.Difficulty
+	if cfg.Dynamic {
+		aiProfile = ai.DynamicProfile.ID
+	}
+	placer.PositionShips(enemyBoard, enemyFleet, service.NewAIPlacement(aiProfile, nil, size))
+
+	cfg.BoardSize, cfg.FleetSpec, cfg.NoTouch = size, spec, noTouch
+	return NewWithBoards(cfg, playerBoard, playerFleet, enemyBoard, enemyFleet, now)
+}
+
+// NewWithBoards cria e inicia uma partida com boards/frotas já posicionados
+// (ex.: o placement feito pelo jogador no cliente Ebiten). A IA usa o gerador
+// da partida, derivado de cfg.Seed. A regra NoTouch vem dos próprios boards.
+func NewWithBoards(cfg Config, playerBoard *entity.Board, playerFleet *entity.Fleet, enemyBoard *entity.Board, enemyFleet *entity.Fleet, now time.Time) (*Game, error) {
+	if playerBoard == nil || playerFleet == nil || enemyBoard == nil || enemyFleet == nil {
+		return nil, service.ErrMatchNotReady
+	}
+	if playerBoard.Size != enemyBoard.Size {
+		return nil, fmt.Errorf("tabuleiros com dimensões diferentes: %d e %d", playerBoard.Size, enemyBoard.Size)
+	}
+
+	id := cfg.ID
+	if id == "" {
+		id = fmt.Sprintf("match-%d", now.UnixNano())
+	}
+
+	m := entity.NewMatch(id, cfg.Difficulty, playerBoard.Size, nil, cfg.Dynamic)
+	m.IsSalvoMode = cfg.Salvo && !cfg.Dynamic
+	m.NoTouch = playerBoard.NoTouch && enemyBoard.NoTouch
+	if cfg.Seed != 0 {
+		m.SetSeed(cfg.Seed)
+	}
+	if len(cfg.FleetSpec.Ships) > 0 {
+		m.FleetSpec = cfg.FleetSpec
+	}
+
+	g := &Game{match: m}
+
+	if cfg.Dynamic {
+		g.dynSvc = service.NewDynamicMatchService(nil, cfg.AIDelay, cfg.Sound)
+		g.matchSvc = g.dynSvc.MatchService
+		g.enemy = ai.NewDynamicAIPlayer(playerFleet, enemyBoard)
+		g.enemy.SetRand(m.Rand())
+	} else {
+		g.matchSvc = service.NewMatchService(nil, cfg.AIDelay, cfg.Sound)
+		g.enemy = service.NewBattleSetupService().InitBattleAI(cfg.Difficulty, playerFleet, playerBoard.Size, m.Rand())
+	}
+
+	if err := g.matchSvc.Start(
+		m,
+		now,
+		playerBoard,
+		enemyBoard,
+		playerFleet,
+		enemyFleet,
+		enemyFleet.TotalCells(),
+		playerFleet.TotalCells(),
+	); err != nil {
+		return nil, err
+	}
+
+	return g, nil
+}
+
+// Match devolve o estado lógico da partida (somente leitura para o cliente).
+func (g *Game) Match() *entity.Match {
+	return g.match
+}
+
+// Record devolve o log da partida (seed, posicionamento e eventos) para replay.
+func (g *Game) Record() *entity.MatchRecord {
+	return g.match.Record
+}
+
+// Enemy devolve a IA adversária.
+func (g *Game) Enemy() *ai.AIPlayer {
+	return g.enemy
+}
+
+// Turn devolve de quem é a vez.
+func (g *Game) Turn() entity.TurnOwner {
+	return g.match.Turn
+}
+
+// Finished indica se a partida terminou.
+func (g *Game) Finished() bool {
+	return g.match.IsFinished()
+}
+
+// Result devolve o MatchResult do ponto de vista do jogador.
+func (g *Game) Result() entity.MatchResult {
+	return g.matchSvc.ResultForPlayer(g.match)
+}
+
+// PlayerAttack aplica um tiro do jogador em (row, col).
+func (g *Game) PlayerAttack(now time.Time, row, col int) (entity.AttackEvent, error) {
+	return g.matchSvc.PlayerAttack(g.match, now, row, col)
+}
+
+// EnemyStep executa UM ataque da IA, respeitando o agendamento do Match.
+func (g *Game) EnemyStep(now time.Time) (entity.AttackEvent, error) {
+	return g.matchSvc.EnemyAttackStep(g.match, now, g.enemy)
+}
+
+// SalvoShots retorna quantos tiros owner dispara na salva atual (modo Salvo).
+func (g *Game) SalvoShots(owner entity.TurnOwner) int {
+	return g.matchSvc.SalvoShots(g.match, owner)
+}
+
+// PlayerSalvo dispara a salva do jogador (modo Salvo) com exatamente SalvoShots alvos.
+func (g *Game) PlayerSalvo(now time.Time, cells []entity.Cell) ([]entity.AttackEvent, error) {
+	return g.matchSvc.PlayerSalvo(g.match, now, cells)
+}
+
+// RunEnemyTurn executa o turno inteiro da IA sem esperar o relógio real:
+// cada passo acontece no horário agendado (NextActionAt). Devolve os eventos
+// gerados e o horário do último passo.
+func (g *Game) RunEnemyTurn(now time.Time) ([]entity.AttackEvent, time.Time, error) {
+	var events []entity.AttackEvent
+
+	for g.match.Turn == entity.TurnEnemy && !g.match.IsFinished() {
+		if g.match.NextActionAt.After(now) {
+			now = g.match.NextActionAt
+		}
+
+		if g.match.IsSalvoMode {
+			salvo, err := g.matchSvc.EnemySalvo(g.match, now, g.enemy)
+			if err != nil {
+				return events, now, err
+			}
+			events = append(events, salvo...)
+			continue
+		}
+
+		ev, err := g.EnemyStep(now)
+		if err != nil {
+			return events, now, err
+		}
+		events = append(events, ev)
+	}
+
+	return events, now, nil
+}
+
+// MovePlayerShip move um navio do jogador uma casa (apenas no modo dinâmico).
+// Consome o turno do jogador.
+func (g *Game) MovePlayerShip(now time.Time, ship *entity.Ship, newRow, newCol int) error {
+	if g.dynSvc == nil {
+		return ErrNotDynamic
+	}
+	return g.dynSvc.MovePlayerShip(g.match, ship, newRow, newCol, now)
+}
@@ -0,0 +1,81 @@
+package engine
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/allanjose001/go-battleship/internal/entity"
+)
+
+// playToEnd joga a partida até o fim: o jogador varre o tabuleiro em ordem,
+// linha a linha, e a IA joga o turno inteiro sempre que for a vez dela.
+func playToEnd(t *testing.T, g *Game, now time.Time) {
+	t.Helper()
+	size := g.Match().PlayerEntityBoard.Size
+
+	next := 0
+	for turns := 0; !g.Finished(); turns++ {
+		if turns > size*size*4 {
+			t.Fatalf("partida não terminou em %d turnos", turns)
+		}
+
+		if g.Turn() == entity.TurnEnemy {
+			var err error
+			if _, now, err = g.RunEnemyTurn(now); err != nil {
+				t.Fatalf("turno da IA: %v", err)
+			}
+			continue
+		}
+
+		if next >= size*size {
+			t.Fatal("jogador atirou em todas as células sem terminar a partida")
+		}
+		now = now.Add(time.Second)
+		if _, err := g.PlayerAttack(now, next/size, next%size); err != nil {
+			t.Fatalf("tiro do jogador em %d: %v", next, err)
+		}
+		next++
+	}
+}
+
+func TestSeededGameRunsToCompletion(t *testing.T) {
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	g, err := New(Config{Difficulty: "hard", Seed: 42}, now)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := g.Match().FleetSpec.ID; got != entity.DefaultFleetSpec.ID {
+		t.Errorf("frota = %q, esperava a padrão %q", got, entity.DefaultFleetSpec.ID)
+	}
+
+	playToEnd(t, g, now)
+
+	m := g.Match()
+	if !m.PlayerFleet.IsFleetDestroyed() && !m.EnemyFleet.IsFleetDestroyed() {
+		t.Fatal("partida terminou sem nenhuma frota destruída")
+	}
+	if g.Record() == nil || len(g.Record().Events) == 0 {
+		t.Fatal("partida terminou sem eventos no registro")
+	}
+}
+
+func TestSameSeedReplaysSameGame(t *testing.T) {
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	play := func() *entity.MatchRecord {
+		g, err := New(Config{ID: "det", Difficulty: "medium", Seed: 7}, now)
+		if err != nil {
+			t.Fatal(err)
+		}
+		playToEnd(t, g, now)
+		return g.Record()
+	}
+
+	a, b := play(), play()
+	if !reflect.DeepEqual(a.Player, b.Player) || !reflect.DeepEqual(a.Enemy, b.Enemy) {
+		t.Error("mesma seed posicionou frotas diferentes")
+	}
+	if !reflect.DeepEqual(a.Events, b.Events) {
+		t.Errorf("mesma seed gerou eventos diferentes (%d e %d eventos)", len(a.Events), len(b.Events))
+	}
+}
@@ -12,17 +12,100 @@ const (
 	Right
 )
 
-const BoardSize = 10
+// DefaultBoardSize é a dimensão usada quando a partida não escolhe outra (10x10).
+const DefaultBoardSize = 10
 
+// MinBoardSize e MaxBoardSize limitam as variantes suportadas (partidas rápidas e longas).
+const (
+	MinBoardSize = 8
+	MaxBoardSize = 15
+)
+
+// BoardSizes lista as dimensões oferecidas na interface, em ordem crescente.
+var BoardSizes = []int{8, 10, 12, 15}
+
+// Board é a fonte da verdade de um tabuleiro durante a batalha: navios, tiros e acertos.
+// O renderer apenas lê daqui.
 type Board struct {
-	Positions [BoardSize][BoardSize]Position
+	Size      int
+	Positions [][]Position
+
+	// NoTouch liga a regra opcional "navios não se encostam, nem na diagonal":
+	// as células em volta de cada navio ficam bloqueadas (Block) e não aceitam outro navio.
+	NoTouch bool
+
+	shots            int // total de ataques aplicados
+	lastRow, lastCol int // posição do último ataque
+}
+
+// NewBoard cria um tabuleiro quadrado size x size.
+// Valores fora de [MinBoardSize, MaxBoardSize] caem para DefaultBoardSize.
+func NewBoard(size int) *Board {
+	size = NormalizeBoardSize(size)
+
+	positions := make([][]Position, size)
+	for i := range positions {
+		positions[i] = make([]Position, size)
+	}
+
+	return &Board{
+		Size:      size,
+		Positions: positions,
+	}
+}
+
+// NormalizeBoardSize garante que size esteja dentro dos limites suportados.
+func NormalizeBoardSize(size int) int {
+	if size < MinBoardSize || size > MaxBoardSize {
+		return DefaultBoardSize
+	}
+	return size
+}
+
+// InBounds verifica se (row, col) está dentro do tabuleiro.
+func (b *Board) InBounds(row, col int) bool {
+	return row >= 0 && row < b.Size && col >= 0 && col < b.Size
+}
+
+// Reset volta todas as posições ao estado inicial, mantendo a dimensão.
+func (b *Board) Reset() {
+	for i := range b.Positions {
+		for j := range b.Positions[i] {
+			b.Positions[i][j] = Position{}
+		}
+	}
+	b.shots = 0
+	b.lastRow, b.lastCol = 0, 0
+}
+
+// ShipAt retorna o navio que ocupa (row, col) ou nil.
+func (b *Board) ShipAt(row, col int) *Ship {
+	if !b.InBounds(row, col) {
+		return nil
+	}
+	return GetShipReference(b.Positions[row][col])
+}
+
+// ShotCount retorna quantos ataques já foram aplicados neste tabuleiro.
+func (b *Board) ShotCount() int {
+	return b.shots
+}
+
+// LastShot retorna a posição do último ataque aplicado.
+func (b *Board) LastShot() (row, col int) {
+	return b.lastRow, b.lastCol
+}
+
+func (b *Board) recordShot(row, col int) {
+	b.shots++
+	b.lastRow, b.lastCol = row, col
 }
 
 // variação A que retorna boolean
 func (b *Board) AttackPositionA(row int, col int) bool {
-	fmt.Printf("atacando %v,%v\n", row, col)
 	if b.CheckPosition(row, col) {
 		attack(&b.Positions[row][col])
+		b.recordShot(row, col)
 
 		return true
 	}
@@ -32,9 +115,9 @@ func (b *Board) AttackPositionA(row int, col int) bool {
 
 // variação B que retorna o navio atacado (ou nil se não houver navio)
 func (b *Board) AttackPositionB(row int, col int) *Ship {
-	fmt.Printf("atacando %v,%v\n", row, col)
 	if b.CheckPosition(row, col) {
 		attack(&b.Positions[row][col])
+		b.recordShot(row, col)
 
 		return GetShipReference(b.Positions[row][col])
 	}
@@ -57,13 +140,17 @@ func (b *Board) PlaceShip(ship *Ship, row int, col int) bool {
 		}
 	}
 
+	ship.Row, ship.Col = row, col
+	if b.NoTouch {
+		b.blockAround(ship)
+	}
 	return true
 
 }
 
 func (b *Board) RemoveShipFromBoard(ship *Ship) {
-	for i := 0; i < 10; i++ {
-		for j := 0; j < 10; j++ {
+	for i := 0; i < b.Size; i++ {
+		for j := 0; j < b.Size; j++ {
 			var currentShip *Ship = GetShipReference(b.Positions[i][j])
 
 			if currentShip == ship {
@@ -74,11 +161,71 @@ func (b *Board) RemoveShipFromBoard(ship *Ship) {
 			}
 		}
 	}
+
+	if b.NoTouch {
+		b.refreshBlocks()
+	}
+}
+
+// blockAround bloqueia as células vizinhas (inclusive diagonais) de ship que não têm navio.
+func (b *Board) blockAround(ship *Ship) {
+	for _, c := range b.neighborhood(ship, ship.Row, ship.Col) {
+		if GetShipReference(b.Positions[c.Row][c.Col]) == nil {
+			Block(&b.Positions[c.Row][c.Col])
+		}
+	}
+}
+
+// refreshBlocks recalcula os bloqueios da regra NoTouch a partir dos navios no tabuleiro,
+// usado quando um navio sai ou muda de lugar (vizinhanças podem se sobrepor).
+func (b *Board) refreshBlocks() {
+	ships := make(map[*Ship]bool)
+	for i := range b.Positions {
+		for j := range b.Positions[i] {
+			Unblock(&b.Positions[i][j])
+			if ship := GetShipReference(b.Positions[i][j]); ship != nil {
+				ships[ship] = true
+			}
+		}
+	}
+	for ship := range ships {
+		b.blockAround(ship)
+	}
+}
+
+// neighborhood devolve as células do tabuleiro em volta de ship posicionado em (row, col),
+// sem as células do próprio navio.
+func (b *Board) neighborhood(ship *Ship, row, col int) []Cell {
+	endRow, endCol := row, col+ship.Size-1
+	if !ship.IsHorizontal() {
+		endRow, endCol = row+ship.Size-1, col
+	}
+
+	var cells []Cell
+	for i := row - 1; i <= endRow+1; i++ {
+		for j := col - 1; j <= endCol+1; j++ {
+			inside := i >= row && i <= endRow && j >= col && j <= endCol
+			if !inside && b.InBounds(i, j) {
+				cells = append(cells, Cell{Row: i, Col: j})
+			}
+		}
+	}
+	return cells
+}
+
+// touchesOtherShip indica se ship, posicionado em (row, col), encostaria em outro navio.
+func (b *Board) touchesOtherShip(ship *Ship, row, col int) bool {
+	for _, c := range b.neighborhood(ship, row, col) {
+		if ref := GetShipReference(b.Positions[c.Row][c.Col]); ref != nil && ref != ship {
+			return true
+		}
+	}
+	return false
 }
 
 func (b *Board) CheckShipPosition(ship *Ship, row int, col int) bool {
 	if ship.IsHorizontal() { //se o navio estiver na horizontal:
-		if col+ship.Size > 10 { // verifica se o navio ultrapassa os limites do tabuleiro
+		if col+ship.Size > b.Size { // verifica se o navio ultrapassa os limites do tabuleiro
 			return false
 		}
 
@@ -88,7 +235,7 @@ func (b *Board) CheckShipPosition(ship *Ship, row int, col int) bool {
 			}
 		}
 	} else { // se o navio estiver na vertical:
-		if ship.Size+row > 10 {
+		if ship.Size+row > b.Size {
 			return false
 		}
 
@@ -103,7 +250,7 @@ func (b *Board) CheckShipPosition(ship *Ship, row int, col int) bool {
 }
 
 func (b *Board) CheckPosition(row int, col int) bool {
-	if row < 0 || row > 9 || col < 0 || col > 9 {
+	if !b.InBounds(row, col) {
 		return false
 	}
 
@@ -111,8 +258,8 @@ func (b *Board) CheckPosition(row int, col int) bool {
 }
 
 func PrintBoard(b *Board) {
-	for i := 0; i < 10; i++ { // itera pelas linhas
-		for j := 0; j < 10; j++ { // itera pelas colunas
+	for i := 0; i < b.Size; i++ { // itera pelas linhas
+		for j := 0; j < b.Size; j++ { // itera pelas colunas
 			if IsAttacked(b.Positions[i][j]) { // se a posição foi atacada
 				if GetShipReference(b.Positions[i][j]) != nil {
 					print("x ") // posição atacada com navio
@@ -136,21 +283,54 @@ func PrintBoard(b *Board) {
 
 
 func (b *Board) MoveShip(ship *Ship, newRow int, newCol int) error {
+    cells, targets, err := b.moveTargets(ship, newRow, newCol)
+    if err != nil {
+        return err
+    }
+
+    // aplicar movimentação: remover referências antigas e colocar nas novas
+    // remove ship das antigas
+    for _, p := range cells {
+        RemoveShip(&b.Positions[p[0]][p[1]])
+        // não altera attacked flag; desbloqueio aqui se desejado:
+        Unblock(&b.Positions[p[0]][p[1]])
+    }
+
+    // coloca ship nas novas posições
+    for _, p := range targets {
+        PlaceShip(&b.Positions[p[0]][p[1]], ship)
+    }
+    ship.Row, ship.Col = newRow, newCol
+    if b.NoTouch {
+        b.refreshBlocks()
+    }
+
+    return nil
+}
+
+// CanMoveShip verifica, sem alterar o tabuleiro, se MoveShip aceitaria o movimento.
+func (b *Board) CanMoveShip(ship *Ship, newRow int, newCol int) error {
+    _, _, err := b.moveTargets(ship, newRow, newCol)
+    return err
+}
+
+// moveTargets valida o movimento de ship para (newRow, newCol) e devolve as
+// células atuais e as células de destino do navio.
+func (b *Board) moveTargets(ship *Ship, newRow int, newCol int) (cells, targets [][2]int, err error) {
     if ship == nil {
-        return fmt.Errorf("ship nil")
+        return nil, nil, fmt.Errorf("ship nil")
     }
 
     // encontra células atuais do navio
-    var cells [][2]int
-    for r := 0; r < BoardSize; r++ {
-        for c := 0; c < BoardSize; c++ {
+    for r := 0; r < b.Size; r++ {
+        for c := 0; c < b.Size; c++ {
             if GetShipReference(b.Positions[r][c]) == ship {
                 cells = append(cells, [2]int{r, c})
             }
         }
     }
     if len(cells) == 0 {
-        return fmt.Errorf("barco não está no tabuleiro")
+        return nil, nil, fmt.Errorf("barco não está no tabuleiro")
     }
 
     // determina coordenada top-left atual do navio (menor row e col)
@@ -169,11 +349,10 @@ func (b *Board) MoveShip(ship *Ship, newRow int, newCol int) error {
 
     // permite somente movimento de 1 célula ortogonal
     if !((abs(dRow) == 1 && dCol == 0) || (abs(dCol) == 1 && dRow == 0)) {
-        return fmt.Errorf("movimento inválido: deve mover exatamente 1 célula ortogonalmente")
+        return nil, nil, fmt.Errorf("movimento inválido: deve mover exatamente 1 célula ortogonalmente")
     }
 
     // gera coords alvo baseado na orientação
-    var targets [][2]int
     if ship.IsHorizontal() {
         for i := 0; i < ship.Size; i++ {
             r := newRow
@@ -191,40 +370,25 @@ func (b *Board) MoveShip(ship *Ship, newRow int, newCol int) error {
     // valida targets dentro do tabuleiro e não colidindo com terceiros
     for _, p := range targets {
         r, c := p[0], p[1]
-        if r < 0 || r >= BoardSize || c < 0 || c >= BoardSize {
-            return fmt.Errorf("alvo fora dos limites")
+        if !b.InBounds(r, c) {
+            return nil, nil, fmt.Errorf("alvo fora dos limites")
         }
         // pode ser válido se a posição for livre (IsValidPosition) OU se já pertencer ao mesmo navio
         ref := GetShipReference(b.Positions[r][c])
         if ref != nil && ref != ship {
-            return fmt.Errorf("alvo colide com outro navio")
+            return nil, nil, fmt.Errorf("alvo colide com outro navio")
         }
-        if !IsValidPosition(b.Positions[r][c]) && ref != ship {
-            // IsValidPosition verifica attacked/blocked/shipReference==nil
-            return fmt.Errorf("posição do alvo não disponível")
+        // com NoTouch o bloqueio pode vir da vizinhança do próprio navio; o contato
+        // com outros navios é verificado logo abaixo
+        if ref != ship && (IsAttacked(b.Positions[r][c]) || (IsBlocked(b.Positions[r][c]) && !b.NoTouch)) {
+            return nil, nil, fmt.Errorf("posição do alvo não disponível")
         }
     }
-
-    // aplicar movimentação: remover referências antigas e colocar nas novas
-    // coleta coords antigas para remoção
-    var olds [][2]int
-    for _, p := range cells {
-        olds = append(olds, p)
-    }
-
-    // remove ship das antigas
-    for _, p := range olds {
-        RemoveShip(&b.Positions[p[0]][p[1]])
-        // não altera attacked flag; desbloqueio aqui se desejado:
-        Unblock(&b.Positions[p[0]][p[1]])
+    if b.NoTouch && b.touchesOtherShip(ship, newRow, newCol) {
+        return nil, nil, fmt.Errorf("alvo encosta em outro navio")
     }
 
-    // coloca ship nas novas posições
-    for _, p := range targets {
-        PlaceShip(&b.Positions[p[0]][p[1]], ship)
-    }
-
-    return nil
+    return cells, targets, nil
 }
 
 func abs(x int) int {
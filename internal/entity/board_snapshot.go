@@ -0,0 +1,120 @@
+package entity
+
+import "fmt"
+
+// ShipState é o estado completo de um navio (posição e dano), na ordem da frota.
+type ShipState struct {
+	Name       string `json:"name"`
+	Size       int    `json:"size"`
+	HitCount   int    `json:"hit_count"`
+	Horizontal bool   `json:"horizontal"`
+	Row        int    `json:"row"`
+	Col        int    `json:"col"`
+}
+
+// ShotState é uma posição já atacada. Hit guarda se havia navio no momento
+// do tiro (no modo dinâmico o navio pode ter saído dali depois) e Ship o
+// índice dele na frota (ausente em saves antigos).
+type ShotState struct {
+	Row  int  `json:"row"`
+	Col  int  `json:"col"`
+	Hit  bool `json:"hit,omitempty"`
+	Ship *int `json:"ship,omitempty"`
+}
+
+// BoardSnapshot é a forma serializável de um Board com a sua frota:
+// navios, tiros, bloqueios e contadores de ataque.
+type BoardSnapshot struct {
+	Size     int         `json:"size"`
+	NoTouch  bool        `json:"no_touch,omitempty"`
+	Ships    []ShipState `json:"ships"`
+	Shots    []ShotState `json:"shots"`
+	Blocked  []Cell      `json:"blocked,omitempty"`
+	ShotsN   int         `json:"shot_count"`
+	LastShot Cell        `json:"last_shot"`
+}
+
+// SnapshotBoard copia o estado de b e da frota f que está posicionada nele.
+func SnapshotBoard(b *Board, f *Fleet) BoardSnapshot {
+	snap := BoardSnapshot{
+		Size:     b.Size,
+		NoTouch:  b.NoTouch,
+		ShotsN:   b.shots,
+		LastShot: Cell{Row: b.lastRow, Col: b.lastCol},
+	}
+
+	if f != nil {
+		for _, ship := range f.Ships {
+			snap.Ships = append(snap.Ships, ShipState{
+				Name:       ship.Name,
+				Size:       ship.Size,
+				HitCount:   ship.HitCount,
+				Horizontal: ship.Horizontal,
+				Row:        ship.Row,
+				Col:        ship.Col,
+			})
+		}
+	}
+
+	for i := range b.Positions {
+		for j, pos := range b.Positions[i] {
+			if pos.attacked {
+				shot := ShotState{Row: i, Col: j, Hit: pos.hit}
+				if idx := FleetShipIndex(f, pos.hitShip); idx >= 0 {
+					shot.Ship = &idx
+				}
+				snap.Shots = append(snap.Shots, shot)
+			}
+			if pos.blocked {
+				snap.Blocked = append(snap.Blocked, Cell{Row: i, Col: j})
+			}
+		}
+	}
+	return snap
+}
+
+// Restore reconstrói o Board e a Fleet descritos no snapshot.
+// Os navios são posicionados antes dos tiros; o dano vem de HitCount,
+// então reaplicar os tiros não conta acertos de novo.
+func (s BoardSnapshot) Restore() (*Board, *Fleet, error) {
+	b := NewBoard(s.Size)
+	if b.Size != s.Size {
+		return nil, nil, fmt.Errorf("dimensão de tabuleiro inválida: %d", s.Size)
+	}
+	b.NoTouch = s.NoTouch
+
+	fleet := &Fleet{}
+	for i, st := range s.Ships {
+		ship := &Ship{Name: st.Name, Size: st.Size, Horizontal: st.Horizontal}
+		if !b.PlaceShip(ship, st.Row, st.Col) {
+			return nil, nil, fmt.Errorf("navio %d (%s) inválido em %d,%d", i, st.Name, st.Row, st.Col)
+		}
+		ship.HitCount = st.HitCount
+		fleet.Ships = append(fleet.Ships, ship)
+	}
+
+	for _, shot := range s.Shots {
+		if !b.InBounds(shot.Row, shot.Col) {
+			return nil, nil, fmt.Errorf("tiro fora do tabuleiro: %d,%d", shot.Row, shot.Col)
+		}
+		pos := &b.Positions[shot.Row][shot.Col]
+		pos.attacked = true
+		pos.hit = shot.Hit
+		switch {
+		case shot.Ship != nil && *shot.Ship >= 0 && *shot.Ship < len(fleet.Ships):
+			pos.hitShip = fleet.Ships[*shot.Ship]
+		case shot.Hit:
+			// saves antigos: o navio atingido é o que ainda está na posição
+			pos.hitShip = pos.shipReference
+		}
+	}
+	for _, c := range s.Blocked {
+		if b.InBounds(c.Row, c.Col) {
+			Block(&b.Positions[c.Row][c.Col])
+		}
+	}
+
+	b.shots = s.ShotsN
+	b.lastRow, b.lastCol = s.LastShot.Row, s.LastShot.Col
+	return b, fleet, nil
+}
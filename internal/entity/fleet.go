@@ -1,18 +1,24 @@
 package entity
 
 type Fleet struct {
-	Ships [6]*Ship
+	Ships []*Ship
 }
 
+// NewFleet cria a frota padrão do jogo (DefaultFleetSpec).
 func NewFleet() *Fleet {
+	return NewFleetFromSpec(DefaultFleetSpec)
+}
+
+// NewFleetFromSpec cria uma frota com um navio para cada unidade da composição,
+// na mesma ordem de FleetSpec.Sizes.
+func NewFleetFromSpec(spec FleetSpec) *Fleet {
 	fleet := &Fleet{}
 
-	fleet.Ships[0] = &Ship{Name: "Porta-Aviões", Size: 6, Horizontal: true}
-	fleet.Ships[1] = &Ship{Name: "Porta-Aviões", Size: 6, Horizontal: true}
-	fleet.Ships[2] = &Ship{Name: "Navio de Guerra", Size: 4, Horizontal: true}
-	fleet.Ships[3] = &Ship{Name: "Navio de Guerra", Size: 4, Horizontal: true}
-	fleet.Ships[4] = &Ship{Name: "Encouraçado", Size: 3, Horizontal: true}
-	fleet.Ships[5] = &Ship{Name: "Submarino", Size: 1, Horizontal: true}
+	for _, ss := range spec.Ships {
+		for i := 0; i < ss.Count; i++ {
+			fleet.Ships = append(fleet.Ships, &Ship{Name: ss.Name, Size: ss.Size, Horizontal: true})
+		}
+	}
 
 	return fleet
 }
@@ -26,10 +32,32 @@ func (fleet *Fleet) IsFleetDestroyed() bool {
 	return true
 }
 
+// DestroyedCount retorna quantos navios da frota já afundaram.
+func (fleet *Fleet) DestroyedCount() int {
+	count := 0
+	for _, ship := range fleet.Ships {
+		if ship != nil && ship.IsDestroyed() {
+			count++
+		}
+	}
+	return count
+}
+
 func (fleet *Fleet) GetFleetShips() (ships []*Ship) {
-	return fleet.Ships[:]
+	return fleet.Ships
 }
 
 func (fleet *Fleet) GetShipByIndex(index int) *Ship {
 	return fleet.Ships[index]
 }
+
+// TotalCells retorna a soma dos tamanhos dos navios (células a acertar para vencer).
+func (fleet *Fleet) TotalCells() int {
+	total := 0
+	for _, ship := range fleet.Ships {
+		if ship != nil {
+			total += ship.Size
+		}
+	}
+	return total
+}
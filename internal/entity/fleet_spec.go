@@ -0,0 +1,139 @@
+package entity
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+// ShipSpec descreve uma classe de navio da frota: nome, tamanho e quantidade.
+type ShipSpec struct {
+	Name  string `json:"name"`
+	Size  int    `json:"size"`
+	Count int    `json:"count"`
+}
+
+// FleetSpec descreve a composição de uma frota. É a única fonte das listas de
+// navios usadas no placement do jogador, no placement da IA e na condição de vitória.
+type FleetSpec struct {
+	ID    string     `json:"id"`
+	Name  string     `json:"name"`
+	Ships []ShipSpec `json:"ships"`
+}
+
+// DefaultFleetSpec é a frota original do jogo (6, 6, 4, 4, 3, 1).
+var DefaultFleetSpec = FleetSpec{
+	ID:   "padrao",
+	Name: "Padrão",
+	Ships: []ShipSpec{
+		{Name: "Porta-Aviões", Size: 6, Count: 2},
+		{Name: "Navio de Guerra", Size: 4, Count: 2},
+		{Name: "Encouraçado", Size: 3, Count: 1},
+		{Name: "Submarino", Size: 1, Count: 1},
+	},
+}
+
+// ClassicFleetSpec é a composição clássica da Hasbro (5, 4, 3, 3, 2).
+var ClassicFleetSpec = FleetSpec{
+	ID:   "classica",
+	Name: "Clássica",
+	Ships: []ShipSpec{
+		{Name: "Porta-Aviões", Size: 5, Count: 1},
+		{Name: "Encouraçado", Size: 4, Count: 1},
+		{Name: "Cruzador", Size: 3, Count: 1},
+		{Name: "Submarino", Size: 3, Count: 1},
+		{Name: "Destróier", Size: 2, Count: 1},
+	},
+}
+
+var (
+	// ErrEmptyFleetSpec indica uma composição sem nenhum navio.
+	ErrEmptyFleetSpec = errors.New("fleet spec has no ships")
+	// ErrFleetDoesNotFit indica que a frota não cabe no tabuleiro escolhido.
+	ErrFleetDoesNotFit = errors.New("fleet does not fit board")
+)
+
+// Sizes retorna o tamanho de cada navio da frota, já expandindo Count, na ordem da composição.
+func (f FleetSpec) Sizes() []int {
+	var sizes []int
+	for _, ss := range f.Ships {
+		for i := 0; i < ss.Count; i++ {
+			sizes = append(sizes, ss.Size)
+		}
+	}
+	return sizes
+}
+
+// ShipCount retorna o número total de navios.
+func (f FleetSpec) ShipCount() int {
+	n := 0
+	for _, ss := range f.Ships {
+		n += ss.Count
+	}
+	return n
+}
+
+// TotalCells retorna o total de células ocupadas pela frota (condição de vitória).
+func (f FleetSpec) TotalCells() int {
+	total := 0
+	for _, ss := range f.Ships {
+		total += ss.Size * ss.Count
+	}
+	return total
+}
+
+// Validate verifica se a composição é coerente e cabe em um tabuleiro boardSize x boardSize.
+// A frota pode ocupar no máximo metade das células, para que o posicionamento aleatório sempre termine.
+func (f FleetSpec) Validate(boardSize int) error {
+	if f.ShipCount() == 0 {
+		return ErrEmptyFleetSpec
+	}
+
+	for _, ss := range f.Ships {
+		if ss.Size <= 0 || ss.Count < 0 {
+			return fmt.Errorf("navio inválido %q (tamanho %d, quantidade %d)", ss.Name, ss.Size, ss.Count)
+		}
+		if ss.Size > boardSize {
+			return fmt.Errorf("%w: navio %q (%d) maior que o tabuleiro %dx%d", ErrFleetDoesNotFit, ss.Name, ss.Size, boardSize, boardSize)
+		}
+	}
+
+	if f.TotalCells()*2 > boardSize*boardSize {
+		return fmt.Errorf("%w: %d células em um tabuleiro %dx%d", ErrFleetDoesNotFit, f.TotalCells(), boardSize, boardSize)
+	}
+	return nil
+}
+
+// FitsNoTouch indica se a frota cabe com folga em um tabuleiro boardSize x boardSize
+// quando os navios não podem se encostar. Cada navio ocupa, com a margem que o separa
+// dos outros, (tamanho+1) x 2 células de um tabuleiro (boardSize+1) x (boardSize+1);
+// exigimos no máximo dois terços dessa área para o posicionamento aleatório terminar.
+func (f FleetSpec) FitsNoTouch(boardSize int) bool {
+	if f.Validate(boardSize) != nil {
+		return false
+	}
+
+	footprint := 0
+	for _, ss := range f.Ships {
+		footprint += (ss.Size + 1) * 2 * ss.Count
+	}
+	return footprint*3 <= (boardSize+1)*(boardSize+1)*2
+}
+
+// ParseFleetSpec decodifica uma composição em JSON e valida sua estrutura básica.
+func ParseFleetSpec(data []byte) (FleetSpec, error) {
+	var spec FleetSpec
+	if err := json.Unmarshal(data, &spec); err != nil {
+		return FleetSpec{}, err
+	}
+	if spec.ID == "" {
+		return FleetSpec{}, errors.New("fleet spec sem id")
+	}
+	if spec.Name == "" {
+		spec.Name = spec.ID
+	}
+	if spec.ShipCount() == 0 {
+		return FleetSpec{}, ErrEmptyFleetSpec
+	}
+	return spec, nil
+}
@@ -2,10 +2,8 @@ package entity
 
 import (
 	"errors"
+	"math/rand"
 	"time"
-
-	"github.com/allanjose001/go-battleship/game/shared/board"
-	"github.com/allanjose001/go-battleship/game/shared/placement"
 )
 
 // Regras / estados
@@ -49,15 +47,29 @@ type AttackEvent struct {
 	Hit      bool      `json:"hit"`
 	GameOver bool      `json:"game_over"`
 	Winner   TurnOwner `json:"winner"` // preenchido só se GameOver=true
+	// Moved indica que o atacante moveu um navio no passo (modo dinâmico)
+	Moved bool `json:"moved,omitempty"`
+
+	// Preenchidos só quando o tiro afundou um navio
+	Sunk      bool   `json:"sunk"`
+	ShipName  string `json:"ship_name,omitempty"`
+	ShipSize  int    `json:"ship_size,omitempty"`
+	ShipCells []Cell `json:"ship_cells,omitempty"`
 }
 
 // Match é a partida.
 // Observação: Boards e IA NÃO são serializáveis e ficam com json:"-".
+// O Match só conhece o estado lógico; geometria e sprites ficam na camada game.
 type Match struct {
 	ID            string      `json:"id"`
 	Status        MatchStatus `json:"status"`
 	Difficulty    string      `json:"difficulty"`
 	IsDynamicMode bool        `json:"is_dynamic_mode"`
+	IsSalvoMode   bool        `json:"is_salvo_mode,omitempty"` // um tiro por navio vivo a cada turno (ver MatchService.PlayerSalvo)
+	NoTouch       bool        `json:"no_touch,omitempty"`      // navios não se encostam, nem na diagonal (ver Board.NoTouch)
+	BoardSize     int         `json:"board_size"`              // dimensão dos dois tabuleiros (BoardSize x BoardSize)
+	FleetSpec     FleetSpec   `json:"fleet_spec"`              // composição das duas frotas
+	Seed          int64       `json:"seed"`                    // semente de toda a aleatoriedade da partida (posicionamento e IA)
 
 	Turn   TurnOwner `json:"turn"`
 	Winner TurnOwner `json:"winner"` // "" enquanto não terminou
@@ -88,15 +100,17 @@ type Match struct {
 	TotalEnemyShipCells  int `json:"total_enemy_ship_cells"`
 	TotalPlayerShipCells int `json:"total_player_ship_cells"`
 
-	LastAttackAt time.Time `json:"-"`     // momento do último ataque do player
-	Score        int       `json:"score"` // score atual atualizado a cada tiro
+	// Alvos já marcados pelo jogador para a próxima salva (modo Salvo)
+	PendingShots []Cell `json:"pending_shots,omitempty"`
+
+	LastAttackAt time.Time `json:"last_attack_at"` // momento do último ataque do player
+	Score        int       `json:"score"`          // score atual atualizado a cada tiro
 
 	// Estado runtime (não persistir)
-	PlayerBoard *board.Board               `json:"-"`
-	EnemyBoard  *board.Board               `json:"-"`
-	PlayerShips []*placement.ShipPlacement `json:"-"`
-	EnemyShips  []*placement.ShipPlacement `json:"-"`
-	Profile     *Profile                   `json:"-"`
+	Profile *Profile     `json:"-"`
+	Record  *MatchRecord `json:"-"` // log da partida para replay (criado no Start do MatchService)
+	rng     *rand.Rand   // gerador derivado de Seed (ver Rand)
+	src     *countingSource
 
 	// Visão lógica do jogador para a IA (entity.Board é o que seu AIPlayer ataca)
 	PlayerEntityBoard *Board `json:"-"`
@@ -107,22 +121,82 @@ type Match struct {
 	EnemyFleet       *Fleet `json:"-"`
 }
 
-func NewMatch(id string, difficulty string, playerBoard, aiBoard *board.Board, ships, enemyShips []*placement.ShipPlacement, profile *Profile, isDynamic bool) *Match {
+func NewMatch(id string, difficulty string, boardSize int, profile *Profile, isDynamic bool) *Match {
 	return &Match{
 		ID:            id,
 		Difficulty:    difficulty,
+		BoardSize:     NormalizeBoardSize(boardSize),
+		FleetSpec:     DefaultFleetSpec,
 		Status:        MatchStatusWaiting,
 		Turn:          TurnPlayer,
 		Winner:        "",
-		PlayerBoard:   playerBoard,
-		EnemyBoard:    aiBoard,
-		PlayerShips:   ships,
-		EnemyShips:    enemyShips,
+		Seed:          time.Now().UnixNano(),
 		Profile:       profile,
 		IsDynamicMode: isDynamic,
 	}
 }
 
+// SetSeed define a semente da partida e recria o gerador derivado dela.
+// Deve ser chamado antes de posicionar as frotas/criar a IA para a partida ser reproduzível.
+func (m *Match) SetSeed(seed int64) {
+	m.Seed = seed
+	m.resetRand()
+}
+
+// Rand devolve o gerador da partida, criando-o a partir de Seed na primeira chamada.
+// Todo componente aleatório da partida (posicionamento, IA) deve usar este gerador.
+func (m *Match) Rand() *rand.Rand {
+	if m.rng == nil {
+		m.resetRand()
+	}
+	return m.rng
+}
+
+// RandDraws devolve quantos valores já foram sorteados do gerador da partida.
+// Junto com Seed, identifica exatamente o ponto da sequência (usado no save da partida).
+func (m *Match) RandDraws() int64 {
+	if m.src == nil {
+		return 0
+	}
+	return m.src.draws
+}
+
+// RestoreRand recria o gerador a partir de Seed e avança draws sorteios,
+// retomando a sequência aleatória de onde a partida salva parou.
+func (m *Match) RestoreRand(draws int64) {
+	m.resetRand()
+	for i := int64(0); i < draws; i++ {
+		m.src.Int63()
+	}
+}
+
+func (m *Match) resetRand() {
+	m.src = &countingSource{src: rand.NewSource(m.Seed).(rand.Source64)}
+	m.rng = rand.New(m.src)
+}
+
+// countingSource conta os sorteios feitos na fonte, já que o estado interno
+// de math/rand não é serializável.
+type countingSource struct {
+	src   rand.Source64
+	draws int64
+}
+
+func (c *countingSource) Int63() int64 {
+	c.draws++
+	return c.src.Int63()
+}
+
+func (c *countingSource) Uint64() uint64 {
+	c.draws++
+	return c.src.Uint64()
+}
+
+func (c *countingSource) Seed(seed int64) {
+	c.src.Seed(seed)
+	c.draws = 0
+}
+
 func (m *Match) IsFinished() bool {
 	return m.Status == MatchStatusFinished
 }
@@ -172,19 +246,15 @@ func (m *Match) Result() MatchResult {
 
 	win := m.Winner == TurnPlayer
 
-	// Nota: killedShips com precisão exige rastrear frota lógica da IA.
-	// Como hoje seu inimigo está só no board visual (setup.RandomlyPlaceAIShips),
-	// eu deixo killedShips = 0 por enquanto.
+	// KilledShips/LostShips saem das frotas lógicas (HitCount de cada navio).
 	killedShips := 0
+	if m.EnemyFleet != nil {
+		killedShips = m.EnemyFleet.DestroyedCount()
+	}
 
-	// LostShips dá para obter da Fleet lógica do player (a IA mantém hitcount).
 	lostShips := 0
 	if m.PlayerFleet != nil {
-		for _, sh := range m.PlayerFleet.GetFleetShips() {
-			if sh != nil && sh.IsDestroyed() {
-				lostShips++
-			}
-		}
+		lostShips = m.PlayerFleet.DestroyedCount()
 	}
 
 	score := m.Score
@@ -197,6 +267,8 @@ func (m *Match) Result() MatchResult {
 		Score:             score,
 		LostShips:         lostShips,
 		KilledShips:       killedShips,
+		FleetShips:        m.FleetSpec.ShipCount(),
+		Seed:              m.Seed,
 		Duration:          dur,
 	}
 }
@@ -0,0 +1,160 @@
+package entity
+
+import (
+	"fmt"
+	"time"
+)
+
+// MatchRecordVersion é a versão do formato de MatchRecord.
+const MatchRecordVersion = 1
+
+// Tipos de evento de um MatchRecord.
+const (
+	RecordAttack = "a" // tiro
+	RecordMove   = "m" // movimento de navio (modo dinâmico)
+)
+
+// ShipRecord é a posição inicial de um navio, na ordem da frota.
+type ShipRecord struct {
+	Size       int  `json:"s"`
+	Row        int  `json:"r"`
+	Col        int  `json:"c"`
+	Horizontal bool `json:"h,omitempty"`
+}
+
+// RecordEvent é um passo da partida. Para RecordAttack, Row/Col é o tiro;
+// para RecordMove, Ship é o índice do navio na frota de By e Row/Col é o novo top-left.
+type RecordEvent struct {
+	Kind string    `json:"k"`
+	By   TurnOwner `json:"by"`
+	Row  int       `json:"r"`
+	Col  int       `json:"c"`
+	Ship int       `json:"i,omitempty"`
+	Hit  bool      `json:"h,omitempty"`
+	Sunk bool      `json:"x,omitempty"`
+	AtMs int64     `json:"t"` // milissegundos desde o início da partida
+}
+
+// MatchRecord é o log compacto de uma partida: configuração, seed, posicionamento
+// inicial das duas frotas e todos os eventos, suficiente para reproduzi-la.
+type MatchRecord struct {
+	Version    int           `json:"v"`
+	ID         string        `json:"id"`
+	Seed       int64         `json:"seed"`
+	Difficulty string        `json:"difficulty"`
+	Dynamic    bool          `json:"dynamic,omitempty"`
+	Salvo      bool          `json:"salvo,omitempty"`
+	NoTouch    bool          `json:"no_touch,omitempty"`
+	BoardSize  int           `json:"board_size"`
+	FleetSpec  FleetSpec     `json:"fleet_spec"`
+	StartedAt  time.Time     `json:"started_at"`
+	Player     []ShipRecord  `json:"player"`
+	Enemy      []ShipRecord  `json:"enemy"`
+	Events     []RecordEvent `json:"events"`
+	Winner     TurnOwner     `json:"winner,omitempty"`
+}
+
+// NewMatchRecord inicia o log a partir do estado atual do Match (frotas já posicionadas).
+func NewMatchRecord(m *Match) *MatchRecord {
+	return &MatchRecord{
+		Version:    MatchRecordVersion,
+		ID:         fmt.Sprintf("%s-%d", m.ID, m.StartedAt.UnixNano()),
+		Seed:       m.Seed,
+		Difficulty: m.Difficulty,
+		Dynamic:    m.IsDynamicMode,
+		Salvo:      m.IsSalvoMode,
+		NoTouch:    m.NoTouch,
+		BoardSize:  m.BoardSize,
+		FleetSpec:  m.FleetSpec,
+		StartedAt:  m.StartedAt,
+		Player:     fleetRecords(m.PlayerFleet),
+		Enemy:      fleetRecords(m.EnemyFleet),
+	}
+}
+
+func fleetRecords(f *Fleet) []ShipRecord {
+	if f == nil {
+		return nil
+	}
+	records := make([]ShipRecord, 0, len(f.Ships))
+	for _, ship := range f.Ships {
+		records = append(records, ShipRecord{Size: ship.Size, Row: ship.Row, Col: ship.Col, Horizontal: ship.Horizontal})
+	}
+	return records
+}
+
+// AddAttack registra um tiro a partir do AttackEvent gerado pelo MatchService.
+func (r *MatchRecord) AddAttack(ev AttackEvent, now time.Time) {
+	r.Events = append(r.Events, RecordEvent{
+		Kind: RecordAttack,
+		By:   ev.Attacker,
+		Row:  ev.Row,
+		Col:  ev.Col,
+		Hit:  ev.Hit,
+		Sunk: ev.Sunk,
+		AtMs: r.elapsed(now),
+	})
+}
+
+// AddMove registra o movimento do navio de índice shipIdx da frota de by.
+func (r *MatchRecord) AddMove(by TurnOwner, shipIdx, row, col int, now time.Time) {
+	r.Events = append(r.Events, RecordEvent{
+		Kind: RecordMove,
+		By:   by,
+		Row:  row,
+		Col:  col,
+		Ship: shipIdx,
+		AtMs: r.elapsed(now),
+	})
+}
+
+func (r *MatchRecord) elapsed(now time.Time) int64 {
+	if r.StartedAt.IsZero() {
+		return 0
+	}
+	return now.Sub(r.StartedAt).Milliseconds()
+}
+
+// BuildBoards monta os boards/frotas iniciais descritos no log.
+func (r *MatchRecord) BuildBoards() (playerBoard *Board, playerFleet *Fleet, enemyBoard *Board, enemyFleet *Fleet, err error) {
+	playerBoard, playerFleet, err = r.buildSide(r.Player)
+	if err != nil {
+		return nil, nil, nil, nil, fmt.Errorf("frota do jogador: %w", err)
+	}
+	enemyBoard, enemyFleet, err = r.buildSide(r.Enemy)
+	if err != nil {
+		return nil, nil, nil, nil, fmt.Errorf("frota inimiga: %w", err)
+	}
+	return playerBoard, playerFleet, enemyBoard, enemyFleet, nil
+}
+
+func (r *MatchRecord) buildSide(ships []ShipRecord) (*Board, *Fleet, error) {
+	b := NewBoard(r.BoardSize)
+	b.NoTouch = r.NoTouch
+	fleet := NewFleetFromSpec(r.FleetSpec)
+	if len(fleet.Ships) != len(ships) {
+		return nil, nil, fmt.Errorf("esperados %d navios, log tem %d", len(fleet.Ships), len(ships))
+	}
+
+	for i, rec := range ships {
+		ship := fleet.Ships[i]
+		ship.Horizontal = rec.Horizontal
+		if ship.Size != rec.Size || !b.PlaceShip(ship, rec.Row, rec.Col) {
+			return nil, nil, fmt.Errorf("navio %d (tamanho %d) inválido em %d,%d", i, rec.Size, rec.Row, rec.Col)
+		}
+	}
+	return b, fleet, nil
+}
+
+// FleetShipIndex devolve o índice de ship em f.Ships (-1 se não pertencer à frota).
+func FleetShipIndex(f *Fleet, ship *Ship) int {
+	if f == nil {
+		return -1
+	}
+	for i, s := range f.Ships {
+		if s == ship {
+			return i
+		}
+	}
+	return -1
+}
@@ -12,6 +12,9 @@ type MatchResult struct {
 	Score             int   `json:"score"`
 	LostShips         int   `json:"lost_ships"`
 	KilledShips       int   `json:"killed_ships"`
+	FleetShips        int   `json:"fleet_ships"` // navios por frota na partida (0 em históricos antigos)
+	Seed              int64 `json:"seed,omitempty"` // semente da partida (reprodução de bugs)
+	ReplayID          string `json:"replay_id,omitempty"` // log salvo pelo ReplayService ("" = sem replay)
 	Duration          int64 `json:"duration"` //-> em milissegundos
 	Mode			  string `json:"mode"`
 }
@@ -0,0 +1,72 @@
+package entity
+
+// PlacementHeat conta, para uma dimensão de tabuleiro, em quantas partidas cada
+// célula teve navio do jogador no posicionamento inicial.
+type PlacementHeat struct {
+	Matches int     `json:"matches"`
+	Cells   [][]int `json:"cells"`
+}
+
+// PlacementHabits guarda os hábitos de posicionamento do jogador de um perfil,
+// separados pela dimensão do tabuleiro. A IA adaptativa usa isso na caça.
+type PlacementHabits struct {
+	Boards map[int]*PlacementHeat `json:"boards,omitempty"`
+}
+
+// Record soma o posicionamento inicial ships de uma partida em tabuleiro boardSize.
+func (h *PlacementHabits) Record(boardSize int, ships []ShipRecord) {
+	if len(ships) == 0 || boardSize <= 0 {
+		return
+	}
+	if h.Boards == nil {
+		h.Boards = make(map[int]*PlacementHeat)
+	}
+
+	heat := h.Boards[boardSize]
+	if heat == nil || len(heat.Cells) != boardSize {
+		heat = &PlacementHeat{Cells: make([][]int, boardSize)}
+		for i := range heat.Cells {
+			heat.Cells[i] = make([]int, boardSize)
+		}
+		h.Boards[boardSize] = heat
+	}
+
+	for _, s := range ships {
+		for k := 0; k < s.Size; k++ {
+			row, col := s.Row+k, s.Col
+			if s.Horizontal {
+				row, col = s.Row, s.Col+k
+			}
+			if row >= 0 && row < boardSize && col >= 0 && col < boardSize {
+				heat.Cells[row][col]++
+			}
+		}
+	}
+	heat.Matches++
+}
+
+// Frequency devolve, para o tabuleiro boardSize, a fração das partidas em que
+// cada célula teve navio (0 a 1), ou nil se ainda não há partidas registradas.
+func (h *PlacementHabits) Frequency(boardSize int) [][]float64 {
+	if h == nil {
+		return nil
+	}
+	heat := h.Boards[boardSize]
+	if heat == nil || heat.Matches == 0 {
+		return nil
+	}
+
+	freq := make([][]float64, len(heat.Cells))
+	for i := range heat.Cells {
+		freq[i] = make([]float64, len(heat.Cells[i]))
+		for j, n := range heat.Cells[i] {
+			freq[i][j] = float64(n) / float64(heat.Matches)
+		}
+	}
+	return freq
+}
+
+// Empty indica se nada foi aprendido ainda.
+func (h *PlacementHabits) Empty() bool {
+	return h == nil || len(h.Boards) == 0
+}
@@ -11,6 +11,7 @@ type PlayerStats struct {
 	HighScore         int   `json:"high_score"`
 	TotalScore        int   `json:"total_score"`
 	HigherHitSequence int   `json:"higher_hit_sequence"`
+	TotalKilledShips  int   `json:"total_killed_ships"`
 	FasterTime        int64 `json:"faster_time"` //tempo em milissegundos
 	WinWithoutLosses  bool  `json:"win_without_losses"`
 }
@@ -44,6 +45,8 @@ func (s *PlayerStats) ApplyMatch(r MatchResult) {
 
 	s.TotalScore += r.Score
 
+	s.TotalKilledShips += r.KilledShips
+
 	if r.Score > s.HighScore {
 		s.HighScore = r.Score
 	}
@@ -2,6 +2,8 @@ package entity
 
 type Position struct {
 	attacked      bool
+	hit           bool  // havia navio na posição no momento do ataque (o navio pode se mover depois)
+	hitShip       *Ship // navio atingido no ataque, mesmo que tenha se movido depois
 	blocked       bool
 	shipReference *Ship
 }
@@ -10,6 +12,8 @@ func attack(pos *Position) {
 	pos.attacked = true
 
 	if pos.shipReference != nil {
+		pos.hit = true
+		pos.hitShip = pos.shipReference
 		pos.shipReference.HitCount += 1
 	}
 }
@@ -34,6 +38,17 @@ func IsAttacked(pos Position) bool {
 	return pos.attacked
 }
 
+// WasHit indica se o ataque à posição acertou um navio.
+func WasHit(pos Position) bool {
+	return pos.hit
+}
+
+// HitShip retorna o navio que o ataque à posição acertou (nil se foi água),
+// mesmo que ele já tenha saído dali no modo dinâmico.
+func HitShip(pos Position) *Ship {
+	return pos.hitShip
+}
+
 func IsBlocked(pos Position) bool {
 	return pos.blocked
 }
@@ -7,6 +7,19 @@ type Profile struct {
 	History         []MatchResult `json:"history"`
 	CurrentCampaign *Campaign     `json:"current_campaign"`
 	Campaigns       []Campaign    `json:"campaigns"`
+
+	// PlacementHabits é onde o jogador costuma posicionar os navios (IA adaptativa).
+	PlacementHabits *PlacementHabits `json:"placement_habits,omitempty"`
+
+	// Adaptive é a nota da dificuldade adaptativa (nil até a primeira partida nela).
+	Adaptive *AdaptiveRating `json:"adaptive,omitempty"`
+}
+
+// AdaptiveRating é o estado interno da dificuldade adaptativa de um perfil:
+// Rating vai de 0 (Recruta com erros) a 3 (Lenda sem handicap).
+type AdaptiveRating struct {
+	Rating  float64 `json:"rating"`
+	Matches int     `json:"matches"`
 }
 
 // HasMedal verifica se player possui medalha
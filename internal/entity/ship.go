@@ -1,10 +1,19 @@
 package entity
 
+// Cell é uma coordenada (linha, coluna) do tabuleiro.
+type Cell struct {
+	Row int `json:"row"`
+	Col int `json:"col"`
+}
+
 type Ship struct {
 	Name       string
 	Size       int
 	HitCount   int
 	Horizontal bool
+	// Row/Col são a posição top-left do navio no tabuleiro, mantidas por Board.PlaceShip/MoveShip
+	Row int
+	Col int
 }
 
 func (s *Ship) Rotate() {
@@ -18,3 +27,16 @@ func (s *Ship) IsDestroyed() bool {
 func (s *Ship) IsHorizontal() bool {
 	return s.Horizontal
 }
+
+// Cells retorna as células ocupadas pelo navio a partir da posição top-left atual.
+func (s *Ship) Cells() []Cell {
+	cells := make([]Cell, 0, s.Size)
+	for i := 0; i < s.Size; i++ {
+		if s.Horizontal {
+			cells = append(cells, Cell{Row: s.Row, Col: s.Col + i})
+		} else {
+			cells = append(cells, Cell{Row: s.Row + i, Col: s.Col})
+		}
+	}
+	return cells
+}
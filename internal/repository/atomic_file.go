@@ -0,0 +1,83 @@
+package repository
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// WriteFileAtomic grava data em path sem nunca deixar o arquivo pela metade:
+// escreve num temporário no mesmo diretório, força o conteúdo para o disco e
+// só então o renomeia por cima de path. Se o processo cair no meio, path
+// continua com o conteúdo anterior. Exportada para os outros arquivos do
+// jogo (ex.: a partida salva em service) gravarem do mesmo jeito.
+func WriteFileAtomic(path string, data []byte, perm os.FileMode) error {
+	dir := filepath.Dir(path)
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return err
+	}
+
+	tmp, err := os.CreateTemp(dir, filepath.Base(path)+".tmp-*")
+	if err != nil {
+		return err
+	}
+	tmpPath := tmp.Name()
+	// em qualquer falha o temporário é descartado (após o rename ele não existe mais)
+	defer os.Remove(tmpPath)
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Sync(); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	if err := os.Chmod(tmpPath, perm); err != nil {
+		return err
+	}
+	if err := os.Rename(tmpPath, path); err != nil {
+		return err
+	}
+	syncDir(dir)
+	return nil
+}
+
+// syncDir grava no disco a entrada do diretório (o rename). Nem todo sistema
+// permite abrir diretórios para isso; aí o erro é ignorado.
+func syncDir(dir string) {
+	d, err := os.Open(dir)
+	if err != nil {
+		return
+	}
+	_ = d.Sync()
+	_ = d.Close()
+}
+
+// backupPath é o caminho do n-ésimo backup de path (1 é o mais recente).
+func backupPath(path string, n int) string {
+	return fmt.Sprintf("%s.bak.%d", path, n)
+}
+
+// rotateBackups empurra os backups de path uma posição (o mais antigo, o
+// n-ésimo, é descartado) e copia o conteúdo atual de path para o backup 1.
+// Sem arquivo em path não há o que copiar e nada muda.
+func rotateBackups(path string, n int) error {
+	current, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return err
+	}
+
+	for i := n; i > 1; i-- {
+		if err := os.Rename(backupPath(path, i-1), backupPath(path, i)); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+	return WriteFileAtomic(backupPath(path, 1), current, 0644)
+}
@@ -0,0 +1,228 @@
+package repository
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/allanjose001/go-battleship/internal/entity"
+)
+
+// Operações gravadas no journal.
+const (
+	journalOpSave   = "save"
+	journalOpDelete = "delete"
+)
+
+// journalEntry é uma linha do journal: um perfil salvo ou um perfil apagado.
+type journalEntry struct {
+	Op       string          `json:"op"`
+	Username string          `json:"username,omitempty"`
+	Profile  *entity.Profile `json:"profile,omitempty"`
+}
+
+// JournalProfileRepository guarda os perfis em um journal append-only: cada
+// Save/Delete acrescenta uma linha JSON ao arquivo, sem reescrever o que já
+// foi gravado, e o estado atual é a reprodução do journal ao abrir. Uma
+// última linha incompleta (gravação interrompida) é descartada e cortada do
+// arquivo, para a próxima linha não ser acrescentada colada a ela. Compact
+// reescreve o journal só com os perfis atuais.
+type JournalProfileRepository struct {
+	path    string
+	mem     *MemoryProfileRepository
+	writeMu sync.Mutex // serializa alteração + gravação da linha
+}
+
+// NewJournalProfileRepository abre (ou prepara para criar) o journal em path.
+func NewJournalProfileRepository(path string) (*JournalProfileRepository, error) {
+	r := &JournalProfileRepository{path: path, mem: NewMemoryProfileRepository()}
+
+	f, err := os.Open(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return r, nil
+		}
+		return nil, err
+	}
+	end, terminated, err := r.replay(bufio.NewReader(f))
+	f.Close()
+	if err != nil {
+		return nil, fmt.Errorf("journal de perfis %s: %w", path, err)
+	}
+	if err := repairTail(path, end, terminated); err != nil {
+		return nil, fmt.Errorf("journal de perfis %s: %w", path, err)
+	}
+	return r, nil
+}
+
+// replay aplica as linhas do journal ao repositório em memória. Devolve até
+// que byte o journal é válido e se esse trecho termina em quebra de linha
+// (uma última linha sem '\n' mas com JSON completo também vale).
+func (r *JournalProfileRepository) replay(rd *bufio.Reader) (end int64, terminated bool, err error) {
+	terminated = true
+	for n := 1; ; n++ {
+		line, readErr := rd.ReadBytes('\n')
+		if readErr != nil && !errors.Is(readErr, io.EOF) {
+			return 0, false, readErr
+		}
+		complete := readErr == nil
+
+		if content := bytes.TrimSpace(line); len(content) > 0 {
+			var entry journalEntry
+			if jsonErr := json.Unmarshal(content, &entry); jsonErr != nil {
+				if !complete {
+					return end, terminated, nil // última linha cortada no meio da gravação
+				}
+				return 0, false, fmt.Errorf("linha %d: %w", n, jsonErr)
+			}
+			if applyErr := r.apply(entry); applyErr != nil {
+				return 0, false, fmt.Errorf("linha %d: %w", n, applyErr)
+			}
+		}
+		if len(line) > 0 {
+			end += int64(len(line))
+			terminated = complete
+		}
+		if !complete {
+			return end, terminated, nil
+		}
+	}
+}
+
+// repairTail deixa o journal pronto para receber linhas: corta o que vem
+// depois de end (a linha incompleta) e termina a última linha com '\n'.
+func repairTail(path string, end int64, terminated bool) error {
+	info, err := os.Stat(path)
+	if err != nil {
+		return err
+	}
+	if info.Size() == end && terminated {
+		return nil
+	}
+
+	f, err := os.OpenFile(path, os.O_WRONLY, 0644)
+	if err != nil {
+		return err
+	}
+	if err := f.Truncate(end); err != nil {
+		f.Close()
+		return err
+	}
+	if !terminated {
+		if _, err := f.WriteAt([]byte{'\n'}, end); err != nil {
+			f.Close()
+			return err
+		}
+	}
+	if err := f.Sync(); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+// apply executa uma entrada do journal no repositório em memória.
+func (r *JournalProfileRepository) apply(entry journalEntry) error {
+	switch entry.Op {
+	case journalOpSave:
+		if entry.Profile == nil {
+			return errors.New("save sem perfil")
+		}
+		return r.mem.Save(*entry.Profile)
+	case journalOpDelete:
+		return r.mem.Delete(entry.Username)
+	default:
+		return fmt.Errorf("operação desconhecida %q", entry.Op)
+	}
+}
+
+func (r *JournalProfileRepository) List() ([]entity.Profile, error) {
+	return r.mem.List()
+}
+
+func (r *JournalProfileRepository) Find(username string) (*entity.Profile, error) {
+	return r.mem.Find(username)
+}
+
+func (r *JournalProfileRepository) Save(profile entity.Profile) error {
+	r.writeMu.Lock()
+	defer r.writeMu.Unlock()
+
+	if err := r.mem.Save(profile); err != nil {
+		return err
+	}
+	return r.append(journalEntry{Op: journalOpSave, Profile: &profile})
+}
+
+func (r *JournalProfileRepository) Delete(username string) error {
+	r.writeMu.Lock()
+	defer r.writeMu.Unlock()
+
+	if _, err := r.mem.Find(username); err != nil {
+		return nil // não encontrou profile, nada a fazer
+	}
+	if err := r.mem.Delete(username); err != nil {
+		return err
+	}
+	return r.append(journalEntry{Op: journalOpDelete, Username: username})
+}
+
+// Compact reescreve o journal com uma linha por perfil atual, descartando o
+// histórico de alterações. O arquivo novo é gravado ao lado e renomeado.
+func (r *JournalProfileRepository) Compact() error {
+	r.writeMu.Lock()
+	defer r.writeMu.Unlock()
+
+	profiles, err := r.mem.List()
+	if err != nil {
+		return err
+	}
+
+	var buf bytes.Buffer
+	for i := range profiles {
+		if err := writeEntry(&buf, journalEntry{Op: journalOpSave, Profile: &profiles[i]}); err != nil {
+			return err
+		}
+	}
+
+	if err := os.MkdirAll(filepath.Dir(r.path), 0755); err != nil {
+		return err
+	}
+	tmp := r.path + ".tmp"
+	if err := os.WriteFile(tmp, buf.Bytes(), 0644); err != nil {
+		return err
+	}
+	return os.Rename(tmp, r.path)
+}
+
+// append acrescenta entry ao fim do journal.
+func (r *JournalProfileRepository) append(entry journalEntry) error {
+	if err := os.MkdirAll(filepath.Dir(r.path), 0755); err != nil {
+		return err
+	}
+	f, err := os.OpenFile(r.path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0644)
+	if err != nil {
+		return err
+	}
+	if err := writeEntry(f, entry); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+// writeEntry grava entry como uma linha JSON.
+func writeEntry(w io.Writer, entry journalEntry) error {
+	data, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(append(data, '\n'))
+	return err
+}
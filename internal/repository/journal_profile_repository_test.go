@@ -0,0 +1,84 @@
+package repository
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/allanjose001/go-battleship/internal/entity"
+)
+
+// reopenJournal abre o journal em path e devolve os nomes dos perfis.
+func reopenJournal(t *testing.T, path string) (*JournalProfileRepository, []string) {
+	t.Helper()
+	r, err := NewJournalProfileRepository(path)
+	if err != nil {
+		t.Fatalf("abrindo journal: %v", err)
+	}
+	profiles, err := r.List()
+	if err != nil {
+		t.Fatal(err)
+	}
+	names := make([]string, 0, len(profiles))
+	for _, p := range profiles {
+		names = append(names, p.Username)
+	}
+	return r, names
+}
+
+// journalWithTail grava um journal com o perfil "ana" seguido de tail.
+func journalWithTail(t *testing.T, tail string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "profiles.journal")
+	r, err := NewJournalProfileRepository(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := r.Save(entity.Profile{Username: "ana"}); err != nil {
+		t.Fatal(err)
+	}
+
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_WRONLY, 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := f.WriteString(tail); err != nil {
+		t.Fatal(err)
+	}
+	if err := f.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestJournalSaveAfterCutLine(t *testing.T) {
+	path := journalWithTail(t, `{"op":"save","profile":{"user`)
+
+	r, names := reopenJournal(t, path)
+	if len(names) != 1 || names[0] != "ana" {
+		t.Fatalf("perfis depois da linha cortada = %v, esperava [ana]", names)
+	}
+	if err := r.Save(entity.Profile{Username: "bia"}); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, names = reopenJournal(t, path); len(names) != 2 {
+		t.Fatalf("perfis depois de salvar = %v, esperava [ana bia]", names)
+	}
+}
+
+func TestJournalSaveAfterUnterminatedLine(t *testing.T) {
+	path := journalWithTail(t, `{"op":"save","profile":{"username":"bia"}}`)
+
+	r, names := reopenJournal(t, path)
+	if len(names) != 2 {
+		t.Fatalf("perfis com a última linha sem quebra = %v, esperava [ana bia]", names)
+	}
+	if err := r.Save(entity.Profile{Username: "caio"}); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, names = reopenJournal(t, path); len(names) != 3 {
+		t.Fatalf("perfis depois de salvar = %v, esperava [ana bia caio]", names)
+	}
+}
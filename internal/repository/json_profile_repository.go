@@ -0,0 +1,229 @@
+package repository
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+
+	"github.com/allanjose001/go-battleship/internal/entity"
+)
+
+// ProfileBackups é quantos backups rotativos do arquivo de perfis são mantidos
+// (path.bak.1 é o mais recente).
+const ProfileBackups = 3
+
+// checksumPrefix identifica o algoritmo do checksum gravado no arquivo.
+const checksumPrefix = "sha256:"
+
+// profileFile é o formato em disco: a versão do formato (ver
+// ProfileSchemaVersion), a lista de perfis e o checksum dela, para detectar
+// arquivos truncados ou corrompidos ao carregar.
+type profileFile struct {
+	Version  int             `json:"version"`
+	Checksum string          `json:"checksum"`
+	Profiles json.RawMessage `json:"profiles"`
+}
+
+// Recovery descreve uma recuperação feita ao abrir o arquivo de perfis: o
+// arquivo principal era inválido e os perfis vieram de um backup.
+type Recovery struct {
+	// Backup é o arquivo de onde os perfis foram recuperados.
+	Backup string
+	// Corrupt é para onde o arquivo inválido foi movido (vazio se não foi possível).
+	Corrupt string
+	// Cause é o motivo de o arquivo principal ter sido rejeitado.
+	Cause error
+}
+
+// JSONProfileRepository guarda todos os perfis em um único arquivo JSON,
+// reescrito por inteiro a cada alteração. É o formato do profiles.json do jogo.
+//
+// A gravação nunca deixa o arquivo pela metade (temporário + rename), guarda
+// o conteúdo anterior em ProfileBackups backups rotativos e inclui um checksum
+// da lista. Se ao abrir o arquivo estiver corrompido, os perfis são recuperados
+// do backup válido mais recente (ver Recovery).
+type JSONProfileRepository struct {
+	path     string
+	mem      *MemoryProfileRepository
+	writeMu  sync.Mutex // serializa alteração + gravação do arquivo
+	recovery *Recovery
+}
+
+// NewJSONProfileRepository abre o arquivo de perfis em path. Arquivo ausente
+// equivale a nenhum perfil; ele só é criado na primeira gravação. Se o arquivo
+// for inválido e nenhum backup servir, devolve erro sem tocar em nada.
+func NewJSONProfileRepository(path string) (*JSONProfileRepository, error) {
+	r := &JSONProfileRepository{path: path, mem: NewMemoryProfileRepository()}
+
+	profiles, err := readProfileFile(path)
+	if err == nil {
+		r.mem.replace(profiles)
+		return r, nil
+	}
+	if os.IsNotExist(err) {
+		return r, nil
+	}
+	if errors.Is(err, ErrProfileSchemaTooNew) {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+
+	cause := err
+	for n := 1; n <= ProfileBackups; n++ {
+		backup := backupPath(path, n)
+		profiles, err := readProfileFile(backup)
+		if err != nil {
+			continue
+		}
+		r.mem.replace(profiles)
+		if err := r.recover(backup, cause); err != nil {
+			return nil, err
+		}
+		return r, nil
+	}
+	return nil, fmt.Errorf("%s inválido e nenhum backup pôde ser usado: %w", path, cause)
+}
+
+// recover guarda o arquivo inválido ao lado do original (path.corrupt) e
+// regrava o arquivo principal com os perfis recuperados de backup.
+func (r *JSONProfileRepository) recover(backup string, cause error) error {
+	rec := &Recovery{Backup: backup, Cause: cause}
+
+	corrupt := r.path + ".corrupt"
+	if err := os.Rename(r.path, corrupt); err == nil {
+		rec.Corrupt = corrupt
+	}
+
+	// o arquivo principal saiu do lugar: gravar agora não rotaciona os backups,
+	// que continuam todos válidos
+	if err := r.persist(); err != nil {
+		return fmt.Errorf("regravando perfis recuperados de %s: %w", backup, err)
+	}
+	r.recovery = rec
+	return nil
+}
+
+// Recovery devolve a recuperação feita ao abrir o arquivo, ou nil se o
+// arquivo principal estava íntegro.
+func (r *JSONProfileRepository) Recovery() *Recovery {
+	return r.recovery
+}
+
+func (r *JSONProfileRepository) List() ([]entity.Profile, error) {
+	return r.mem.List()
+}
+
+func (r *JSONProfileRepository) Find(username string) (*entity.Profile, error) {
+	return r.mem.Find(username)
+}
+
+func (r *JSONProfileRepository) Save(profile entity.Profile) error {
+	r.writeMu.Lock()
+	defer r.writeMu.Unlock()
+
+	if err := r.mem.Save(profile); err != nil {
+		return err
+	}
+	return r.persist()
+}
+
+func (r *JSONProfileRepository) Delete(username string) error {
+	r.writeMu.Lock()
+	defer r.writeMu.Unlock()
+
+	if _, err := r.mem.Find(username); err != nil {
+		return nil // não encontrou profile, nada a fazer
+	}
+	if err := r.mem.Delete(username); err != nil {
+		return err
+	}
+	return r.persist()
+}
+
+// persist reescreve o arquivo com a lista atual de perfis, depois de guardar
+// o conteúdo anterior nos backups.
+func (r *JSONProfileRepository) persist() error {
+	r.mem.mu.RLock()
+	profiles := r.mem.snapshot()
+	if profiles == nil {
+		profiles = []entity.Profile{}
+	}
+	data, err := encodeProfileFile(profiles)
+	r.mem.mu.RUnlock()
+	if err != nil {
+		return err
+	}
+
+	if err := rotateBackups(r.path, ProfileBackups); err != nil {
+		return fmt.Errorf("rotacionando backups de %s: %w", r.path, err)
+	}
+	return WriteFileAtomic(r.path, data, 0644)
+}
+
+// encodeProfileFile monta o arquivo de perfis com o checksum da lista.
+func encodeProfileFile(profiles []entity.Profile) ([]byte, error) {
+	list, err := json.Marshal(profiles)
+	if err != nil {
+		return nil, err
+	}
+	file := profileFile{Version: ProfileSchemaVersion, Checksum: profilesChecksum(list), Profiles: list}
+	return json.MarshalIndent(file, "", "  ")
+}
+
+// readProfileFile carrega e valida o arquivo de perfis em path e o migra para
+// a versão atual. Aceita também o formato antigo, uma lista de perfis sem
+// envelope (versão 1).
+func readProfileFile(path string) ([]entity.Profile, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	trimmed := bytes.TrimSpace(data)
+	if len(trimmed) == 0 {
+		return nil, errors.New("arquivo vazio")
+	}
+
+	list, version := json.RawMessage(trimmed), 1
+	if trimmed[0] == '{' {
+		var file profileFile
+		if err := json.Unmarshal(trimmed, &file); err != nil {
+			return nil, err
+		}
+		if file.Profiles == nil {
+			return nil, errors.New("arquivo sem lista de perfis")
+		}
+		if sum := profilesChecksum(file.Profiles); sum != file.Checksum {
+			return nil, fmt.Errorf("checksum não confere (gravado %q, calculado %q)", file.Checksum, sum)
+		}
+		// o envelope surgiu sem o campo de versão, já com o formato 2
+		list, version = file.Profiles, max(file.Version, 2)
+	}
+
+	list, err = migrateProfiles(version, list)
+	if err != nil {
+		return nil, err
+	}
+
+	var profiles []entity.Profile
+	if err := json.Unmarshal(list, &profiles); err != nil {
+		return nil, err
+	}
+	return profiles, nil
+}
+
+// profilesChecksum calcula o checksum da lista em JSON, ignorando espaços e
+// indentação (o arquivo é gravado indentado).
+func profilesChecksum(list json.RawMessage) string {
+	var compact bytes.Buffer
+	if err := json.Compact(&compact, list); err != nil {
+		compact.Reset()
+		compact.Write(list)
+	}
+	sum := sha256.Sum256(compact.Bytes())
+	return checksumPrefix + hex.EncodeToString(sum[:])
+}
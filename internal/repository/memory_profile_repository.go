@@ -0,0 +1,103 @@
+package repository
+
+import (
+	"sync"
+
+	"github.com/allanjose001/go-battleship/internal/entity"
+)
+
+// MemoryProfileRepository guarda os perfis só em memória. Serve para testes e
+// ferramentas que não devem tocar no save real, e como base das implementações
+// em arquivo.
+type MemoryProfileRepository struct {
+	mu       sync.RWMutex
+	profiles []entity.Profile
+}
+
+// NewMemoryProfileRepository cria um repositório em memória com cópias de profiles.
+func NewMemoryProfileRepository(profiles ...entity.Profile) *MemoryProfileRepository {
+	r := &MemoryProfileRepository{}
+	for _, p := range profiles {
+		_ = r.Save(p)
+	}
+	return r
+}
+
+func (r *MemoryProfileRepository) List() ([]entity.Profile, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	list := make([]entity.Profile, 0, len(r.profiles))
+	for _, p := range r.profiles {
+		clone, err := cloneProfile(p)
+		if err != nil {
+			return nil, err
+		}
+		list = append(list, clone)
+	}
+	return list, nil
+}
+
+func (r *MemoryProfileRepository) Find(username string) (*entity.Profile, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	i := r.index(username)
+	if i < 0 {
+		return nil, notFound(username)
+	}
+	clone, err := cloneProfile(r.profiles[i])
+	if err != nil {
+		return nil, err
+	}
+	return &clone, nil
+}
+
+func (r *MemoryProfileRepository) Save(profile entity.Profile) error {
+	clone, err := cloneProfile(profile)
+	if err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if i := r.index(profile.Username); i >= 0 {
+		r.profiles[i] = clone
+	} else {
+		r.profiles = append(r.profiles, clone)
+	}
+	return nil
+}
+
+func (r *MemoryProfileRepository) Delete(username string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if i := r.index(username); i >= 0 {
+		r.profiles = append(r.profiles[:i], r.profiles[i+1:]...)
+	}
+	return nil
+}
+
+// snapshot devolve os perfis guardados, sem copiar (uso interno, com o lock do chamador).
+func (r *MemoryProfileRepository) snapshot() []entity.Profile {
+	return r.profiles
+}
+
+// replace troca todos os perfis guardados de uma vez.
+func (r *MemoryProfileRepository) replace(profiles []entity.Profile) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.profiles = profiles
+}
+
+// index devolve a posição do perfil de username ou -1.
+func (r *MemoryProfileRepository) index(username string) int {
+	for i, p := range r.profiles {
+		if p.Username == username {
+			return i
+		}
+	}
+	return -1
+}
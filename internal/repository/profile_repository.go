@@ -0,0 +1,46 @@
+// Package repository guarda os dados persistentes do jogo atrás de interfaces,
+// para que os serviços não dependam de onde (ou se) eles são gravados.
+package repository
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/allanjose001/go-battleship/internal/entity"
+)
+
+// ErrProfileNotFound indica que não existe perfil com o username pedido.
+var ErrProfileNotFound = errors.New("perfil não encontrado")
+
+// ProfileRepository guarda os perfis dos jogadores, identificados pelo Username.
+// Os perfis devolvidos são cópias: alterá-los não muda o repositório até Save.
+type ProfileRepository interface {
+	// List devolve todos os perfis na ordem de criação.
+	List() ([]entity.Profile, error)
+	// Find devolve o perfil de username (ErrProfileNotFound se não existir).
+	Find(username string) (*entity.Profile, error)
+	// Save cria o perfil ou substitui o de mesmo Username.
+	Save(profile entity.Profile) error
+	// Delete apaga o perfil de username; não é erro se ele não existir.
+	Delete(username string) error
+}
+
+// cloneProfile copia p por inteiro (histórico, campanha, hábitos), para que o
+// chamador e o repositório nunca compartilhem slices, mapas ou ponteiros.
+func cloneProfile(p entity.Profile) (entity.Profile, error) {
+	data, err := json.Marshal(p)
+	if err != nil {
+		return entity.Profile{}, err
+	}
+	var clone entity.Profile
+	if err := json.Unmarshal(data, &clone); err != nil {
+		return entity.Profile{}, err
+	}
+	return clone, nil
+}
+
+// notFound embrulha ErrProfileNotFound com o username pedido.
+func notFound(username string) error {
+	return fmt.Errorf("%w: %s", ErrProfileNotFound, username)
+}
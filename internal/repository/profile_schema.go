@@ -0,0 +1,150 @@
+package repository
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+// ProfileSchemaVersion é a versão atual do formato do arquivo de perfis.
+//
+// Histórico:
+//   - 1: lista de perfis sem envelope; partidas do histórico sem "mode" e
+//     "difficulty", perfis sem campanhas.
+//   - 2: partidas com "mode" e "difficulty", perfis com "current_campaign" e
+//     "campaigns" (ainda lista sem envelope, indistinguível da versão 1).
+//   - 3: envelope {"version", "checksum", "profiles"}; estatísticas com
+//     "total_killed_ships".
+//
+// Para mudar o formato de entity.Profile, PlayerStats, MatchResult ou Campaign
+// (campo novo com padrão diferente do zero, campo renomeado ou removido),
+// incremente a versão, acrescente a migração em profileMigrations e um
+// fixture em testdata/ com um arquivo da versão anterior. Os fixtures
+// profiles_v1.json e profiles_v2.json são arquivos de cada versão antiga;
+// profiles_v3.json é o resultado de migrar profiles_v2.json.
+const ProfileSchemaVersion = 3
+
+// ErrProfileSchemaTooNew indica um arquivo de perfis gravado por uma versão
+// mais nova do jogo. Ele não é tratado como corrompido: nada é recuperado de
+// backup nem sobrescrito.
+var ErrProfileSchemaTooNew = errors.New("arquivo de perfis de uma versão mais nova do jogo")
+
+// profileMigration leva um perfil, decodificado como JSON genérico, da
+// versão i+1 para a i+2 (profileMigrations[i]). Migrações só preenchem ou
+// renomeiam o que falta, então rodá-las sobre um perfil já migrado não muda nada.
+type profileMigration func(profile map[string]any) error
+
+var profileMigrations = []profileMigration{
+	migrateProfileV1ToV2,
+	migrateProfileV2ToV3,
+}
+
+// legacyModeName é o modo das partidas gravadas antes de existir o campo
+// "mode": só havia a batalha clássica.
+const legacyModeName = "Clássica"
+
+// migrateProfileV1ToV2 preenche o modo das partidas antigas e as campanhas.
+func migrateProfileV1ToV2(profile map[string]any) error {
+	history, err := objectList(profile, "history")
+	if err != nil {
+		return err
+	}
+	for _, match := range history {
+		if mode, _ := match["mode"].(string); mode == "" {
+			match["mode"] = legacyModeName
+		}
+		if _, ok := match["difficulty"]; !ok {
+			match["difficulty"] = ""
+		}
+	}
+
+	if _, ok := profile["current_campaign"]; !ok {
+		profile["current_campaign"] = nil
+	}
+	if campaigns, ok := profile["campaigns"]; !ok || campaigns == nil {
+		profile["campaigns"] = []any{}
+	}
+	return nil
+}
+
+// migrateProfileV2ToV3 calcula o total de navios afundados, que passou a ser
+// acumulado nas estatísticas, a partir do histórico.
+func migrateProfileV2ToV3(profile map[string]any) error {
+	stats, ok := profile["player_stats"].(map[string]any)
+	if !ok {
+		return nil // sem estatísticas: o perfil carrega com os zeros
+	}
+	if _, ok := stats["total_killed_ships"]; ok {
+		return nil
+	}
+
+	history, err := objectList(profile, "history")
+	if err != nil {
+		return err
+	}
+	var total int64
+	for _, match := range history {
+		if n, ok := match["killed_ships"].(json.Number); ok {
+			killed, err := n.Int64()
+			if err != nil {
+				return fmt.Errorf("killed_ships: %w", err)
+			}
+			total += killed
+		}
+	}
+	stats["total_killed_ships"] = total
+	return nil
+}
+
+// migrateProfiles leva a lista de perfis gravada na versão version para
+// ProfileSchemaVersion. Arquivos de uma versão mais nova (gravados por um jogo
+// mais novo) são recusados para não perder os campos que esta versão não conhece.
+func migrateProfiles(version int, list json.RawMessage) (json.RawMessage, error) {
+	if version == ProfileSchemaVersion {
+		return list, nil
+	}
+	if version > ProfileSchemaVersion {
+		return nil, fmt.Errorf("%w (versão %d, suportada até %d)", ErrProfileSchemaTooNew, version, ProfileSchemaVersion)
+	}
+	if version < 1 {
+		return nil, fmt.Errorf("versão %d do arquivo de perfis inválida", version)
+	}
+
+	// json.Number preserva inteiros grandes (sementes) na ida e volta
+	dec := json.NewDecoder(bytes.NewReader(list))
+	dec.UseNumber()
+	var profiles []map[string]any
+	if err := dec.Decode(&profiles); err != nil {
+		return nil, err
+	}
+
+	for i, profile := range profiles {
+		for v := version; v < ProfileSchemaVersion; v++ {
+			if err := profileMigrations[v-1](profile); err != nil {
+				return nil, fmt.Errorf("perfil %d, migração %d->%d: %w", i, v, v+1, err)
+			}
+		}
+	}
+	return json.Marshal(profiles)
+}
+
+// objectList devolve o campo key de obj como lista de objetos (nil se ausente).
+func objectList(obj map[string]any, key string) ([]map[string]any, error) {
+	raw, ok := obj[key].([]any)
+	if !ok {
+		if obj[key] == nil {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("%q não é uma lista", key)
+	}
+	list := make([]map[string]any, 0, len(raw))
+	for i, item := range raw {
+		m, ok := item.(map[string]any)
+		if !ok {
+			return nil, fmt.Errorf("%q[%d] não é um objeto", key, i)
+		}
+		list = append(list, m)
+	}
+	return list, nil
+}
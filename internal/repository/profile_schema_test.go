@@ -0,0 +1,175 @@
+package repository
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/allanjose001/go-battleship/internal/entity"
+)
+
+// loadFixture abre um fixture de testdata/ pelo repositório JSON e devolve os perfis.
+func loadFixture(t *testing.T, name string) ([]entity.Profile, *JSONProfileRepository) {
+	t.Helper()
+	r, err := NewJSONProfileRepository(filepath.Join("testdata", name))
+	if err != nil {
+		t.Fatalf("%s: %v", name, err)
+	}
+	profiles, err := r.List()
+	if err != nil {
+		t.Fatalf("%s: %v", name, err)
+	}
+	return profiles, r
+}
+
+// copyFixture copia um fixture para um diretório temporário, para o teste
+// poder alterá-lo sem mexer em testdata/.
+func copyFixture(t *testing.T, name string) string {
+	t.Helper()
+	data, err := os.ReadFile(filepath.Join("testdata", name))
+	if err != nil {
+		t.Fatal(err)
+	}
+	path := filepath.Join(t.TempDir(), "profiles.json")
+	if err := os.WriteFile(path, data, 0644); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestMigrateV1(t *testing.T) {
+	profiles, r := loadFixture(t, "profiles_v1.json")
+	if r.Recovery() != nil {
+		t.Fatalf("v1 não deveria precisar de recuperação: %+v", r.Recovery())
+	}
+	if len(profiles) != 2 {
+		t.Fatalf("esperava 2 perfis, veio %d", len(profiles))
+	}
+
+	p := profiles[0]
+	if p.Stats.TotalKilledShips != 8 {
+		t.Errorf("total_killed_ships = %d, esperava 8 (soma do histórico)", p.Stats.TotalKilledShips)
+	}
+	for i, match := range p.History {
+		if match.Mode != "Clássica" {
+			t.Errorf("history[%d].mode = %q, esperava \"Clássica\"", i, match.Mode)
+		}
+	}
+	for _, p := range profiles {
+		if p.Campaigns == nil {
+			t.Errorf("%s: campaigns deveria ser lista vazia", p.Username)
+		}
+		if p.CurrentCampaign != nil {
+			t.Errorf("%s: current_campaign deveria ser nil", p.Username)
+		}
+	}
+}
+
+func TestMigrateV2MatchesV3(t *testing.T) {
+	v2, _ := loadFixture(t, "profiles_v2.json")
+	v3, _ := loadFixture(t, "profiles_v3.json")
+
+	if !reflect.DeepEqual(v2, v3) {
+		t.Fatalf("profiles_v2.json migrado difere de profiles_v3.json:\nv2: %+v\nv3: %+v", v2, v3)
+	}
+	if got := v2[0].Stats.TotalKilledShips; got != 14 {
+		t.Errorf("total_killed_ships = %d, esperava 14", got)
+	}
+	if got := v2[0].History[0].Mode; got != "Clássica" {
+		t.Errorf("mode vazio migrou para %q, esperava \"Clássica\"", got)
+	}
+}
+
+func TestLoadV3Unchanged(t *testing.T) {
+	path := copyFixture(t, "profiles_v3.json")
+	before, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	r, err := NewJSONProfileRepository(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r.Recovery() != nil {
+		t.Fatalf("v3 não deveria precisar de recuperação: %+v", r.Recovery())
+	}
+
+	// regravar os mesmos perfis produz o mesmo arquivo
+	profiles, _ := r.List()
+	for _, p := range profiles {
+		if err := r.Save(p); err != nil {
+			t.Fatal(err)
+		}
+	}
+	after, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(before) != string(after) {
+		t.Errorf("arquivo v3 mudou ao ser regravado:\nantes: %s\ndepois: %s", before, after)
+	}
+}
+
+func TestRejectNewerSchema(t *testing.T) {
+	path := copyFixture(t, "profiles_v3.json")
+	data, _ := os.ReadFile(path)
+	newer := strings.Replace(string(data), `"version": 3`, `"version": 99`, 1)
+	if newer == string(data) {
+		t.Fatal("fixture v3 sem o campo version")
+	}
+	if err := os.WriteFile(path, []byte(newer), 0644); err != nil {
+		t.Fatal(err)
+	}
+	// backup válido que não pode ser usado no lugar do arquivo mais novo
+	if err := os.WriteFile(backupPath(path, 1), []byte("[]"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	_, err := NewJSONProfileRepository(path)
+	if !errors.Is(err, ErrProfileSchemaTooNew) {
+		t.Fatalf("err = %v, esperava ErrProfileSchemaTooNew", err)
+	}
+	after, _ := os.ReadFile(path)
+	if string(after) != newer {
+		t.Error("arquivo mais novo foi alterado")
+	}
+	if _, err := os.Stat(path + ".corrupt"); !os.IsNotExist(err) {
+		t.Error("arquivo mais novo não deveria ser tratado como corrompido")
+	}
+}
+
+func TestChecksumMismatchRecoversFromBackup(t *testing.T) {
+	path := copyFixture(t, "profiles_v3.json")
+	backup, _ := os.ReadFile(path)
+	if err := os.WriteFile(backupPath(path, 1), backup, 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	// JSON válido, mas com o conteúdo alterado depois do checksum
+	tampered := strings.Replace(string(backup), `"marinheiro"`, `"pirata"`, 1)
+	if err := os.WriteFile(path, []byte(tampered), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	r, err := NewJSONProfileRepository(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rec := r.Recovery()
+	if rec == nil {
+		t.Fatal("checksum errado deveria recuperar do backup")
+	}
+	if rec.Backup != backupPath(path, 1) {
+		t.Errorf("recuperado de %s, esperava %s", rec.Backup, backupPath(path, 1))
+	}
+	if _, err := r.Find("marinheiro"); err != nil {
+		t.Errorf("perfil do backup não foi recuperado: %v", err)
+	}
+	if _, err := os.Stat(path + ".corrupt"); err != nil {
+		t.Errorf("arquivo inválido deveria ficar em .corrupt: %v", err)
+	}
+}
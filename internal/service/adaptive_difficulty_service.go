@@ -0,0 +1,132 @@
+package service
+
+import (
+	"math"
+
+	"github.com/allanjose001/go-battleship/internal/ai"
+	"github.com/allanjose001/go-battleship/internal/entity"
+)
+
+// AdaptiveDifficulty é a dificuldade que ajusta a IA aos resultados do perfil.
+const AdaptiveDifficulty = "adaptive"
+
+const (
+	// adaptiveRecentMatches é quantos resultados recentes do histórico pesam no ajuste.
+	adaptiveRecentMatches = 10
+	// adaptiveTargetWinRate é a taxa de vitória que a dificuldade adaptativa busca.
+	adaptiveTargetWinRate = 0.5
+	// adaptiveMaxRating é a nota máxima (Lenda sem handicap).
+	adaptiveMaxRating = 3.0
+	// adaptiveStep é o passo base da nota por partida; cresce quando a taxa
+	// recente está longe do alvo, para convergir mais rápido.
+	adaptiveStep = 0.25
+)
+
+var (
+	// adaptiveTiers são os perfis base da dificuldade adaptativa, do mais fraco ao mais forte.
+	adaptiveTiers = []ai.Profile{ai.EasyProfile, ai.MediumProfile, ai.HardProfile, ai.ExpertProfile}
+	// adaptiveMaxBlunder é a chance de erro no começo de cada faixa de nota; na
+	// nota 0 a IA só atira ao acaso, como o Recruta.
+	adaptiveMaxBlunder = []float64{1, 0.5, 0.5}
+)
+
+// ResolvePlayerAIProfile devolve o perfil de IA da dificuldade contra o jogador
+// player: a dificuldade adaptativa depende do perfil, as demais não.
+func ResolvePlayerAIProfile(difficulty string, player *entity.Profile) ai.Profile {
+	if difficulty == AdaptiveDifficulty {
+		return AdaptiveAIProfile(player)
+	}
+	return ResolveAIProfile(difficulty)
+}
+
+// AdaptiveAIProfile monta a IA da dificuldade adaptativa para a nota do perfil.
+// A parte inteira da nota escolhe a faixa: a IA usada é a da faixa seguinte,
+// com um handicap de erros (estratégia blunder) que cai do máximo da faixa
+// (adaptiveMaxBlunder) a zero ao longo dela. Quanto mais forte a IA, menor a
+// pausa antes da jogada.
+func AdaptiveAIProfile(player *entity.Profile) ai.Profile {
+	rating := AdaptiveRatingOf(player)
+
+	tier := min(int(rating), len(adaptiveTiers)-2)
+	frac := rating - float64(tier)
+	base := adaptiveTiers[tier+1]
+	blunder := math.Max(0, (1-frac)*adaptiveMaxBlunder[tier])
+
+	p := base
+	p.ID = AdaptiveDifficulty
+	p.Name = "Adaptativa"
+	p.Strategies = append([]ai.StrategySpec{
+		{Name: "blunder", Params: map[string]float64{"chance": blunder}},
+	}, base.Strategies...)
+	// de 1200ms (nota 0) a 400ms (nota máxima)
+	p.ThinkDelayMs = 1200 - int(rating/adaptiveMaxRating*800)
+	return p
+}
+
+// AdaptiveRatingOf devolve a nota adaptativa do perfil. Sem nota salva, ela é
+// estimada pelos últimos resultados do histórico (perfil novo começa no Imediato).
+func AdaptiveRatingOf(player *entity.Profile) float64 {
+	if player == nil {
+		return 1
+	}
+	if player.Adaptive != nil {
+		return clampRating(player.Adaptive.Rating)
+	}
+
+	winRate, n := recentWinRate(player, adaptiveRecentMatches)
+	if n == 0 {
+		return 1
+	}
+	return clampRating(1.5 + (winRate-adaptiveTargetWinRate)*adaptiveMaxRating)
+}
+
+// UpdateAdaptiveRating ajusta a nota do perfil depois de uma partida adaptativa:
+// sobe com vitória e desce com derrota, com passo maior quando a taxa de vitória
+// dos últimos resultados (já incluindo este) está longe do alvo. Só altera o
+// perfil em memória: quem chama decide quando salvar.
+func UpdateAdaptiveRating(player *entity.Profile, win bool) {
+	if player == nil {
+		return
+	}
+	rating := AdaptiveRatingOf(player)
+
+	winRate, n := recentWinRate(player, adaptiveRecentMatches-1)
+	result := 0.0
+	if win {
+		result = 1
+	}
+	winRate = (winRate*float64(n) + result) / float64(n+1)
+
+	step := adaptiveStep * (1 + 2*math.Abs(winRate-adaptiveTargetWinRate))
+	rating += step * (result - adaptiveTargetWinRate) * 2
+
+	if player.Adaptive == nil {
+		player.Adaptive = &entity.AdaptiveRating{}
+	}
+	player.Adaptive.Rating = clampRating(rating)
+	player.Adaptive.Matches++
+}
+
+// recentWinRate devolve a taxa de vitória dos últimos n resultados do histórico
+// e quantos resultados entraram na conta.
+func recentWinRate(player *entity.Profile, n int) (float64, int) {
+	history := player.History
+	if len(history) > n {
+		history = history[len(history)-n:]
+	}
+	if len(history) == 0 {
+		return 0, 0
+	}
+
+	wins := 0
+	for _, res := range history {
+		if res.Win {
+			wins++
+		}
+	}
+	return float64(wins) / float64(len(history)), len(history)
+}
+
+func clampRating(r float64) float64 {
+	return math.Min(math.Max(r, 0), adaptiveMaxRating)
+}
@@ -3,19 +3,44 @@
 // - Resetar o entity.Board
 // - Tentar colocar cada entity.Ship aleatoriamente sem colisões
 // - Definir orientação horizontal/vertical por sorteio
+// - Respeitar a regra NoTouch do board (o próprio Board.PlaceShip recusa navios encostados)
+// - Seguir a política de posicionamento do perfil da IA (ver AIPlacement)
 // É uma peça de domínio da IA (não visual).
 package service
 
 import (
 	"math/rand"
+	"sort"
+	"time"
 
+	"github.com/allanjose001/go-battleship/internal/ai"
 	"github.com/allanjose001/go-battleship/internal/entity"
 )
 
-type AIFleetService struct{}
+// placementSalt separa a semente do posicionamento da semente da partida.
+const placementSalt int64 = 0x5eed_f1ee7
 
-func NewAIFleetService() *AIFleetService {
-	return &AIFleetService{}
+// NewPlacementRand cria o gerador do posicionamento da frota do jogador numa
+// partida de semente seed. A IA usa o gerador da partida (Match.Rand, semeado
+// com seed); se o posicionamento usasse a mesma semente, os tiros aleatórios da
+// IA repetiriam a sequência que posicionou a frota do jogador e cairiam nos
+// navios dele. Por isso a semente daqui é derivada de seed com placementSalt:
+// mesma seed, mesma partida, mas sequências independentes.
+func NewPlacementRand(seed int64) *rand.Rand {
+	return rand.New(rand.NewSource(seed ^ placementSalt))
+}
+
+type AIFleetService struct {
+	rng *rand.Rand
+}
+
+// NewAIFleetService cria o serviço usando o gerador da partida (entity.Match.Rand).
+// rng nil usa um gerador com semente de relógio (posicionamento não reproduzível).
+func NewAIFleetService(rng *rand.Rand) *AIFleetService {
+	if rng == nil {
+		rng = rand.New(rand.NewSource(time.Now().UnixNano()))
+	}
+	return &AIFleetService{rng: rng}
 }
 
 // PositionShipsRandomly:
@@ -42,11 +67,7 @@ func (s *AIFleetService) PositionShipsRandomly(b *entity.Board, f *entity.Fleet)
 
 // resetBoard: volta todas as posições ao estado inicial
 func (s *AIFleetService) resetBoard(b *entity.Board) {
-	for i := 0; i < entity.BoardSize; i++ {
-		for j := 0; j < entity.BoardSize; j++ {
-			b.Positions[i][j] = entity.Position{}
-		}
-	}
+	b.Reset()
 }
 
 // placeRandomShip: sorteia posição e orientação e tenta colocar o navio
@@ -56,15 +77,15 @@ func (s *AIFleetService) placeRandomShip(b *entity.Board, ship *entity.Ship) boo
 	}
 
 	for attempts := 0; attempts < 1000; attempts++ {
-		ship.Horizontal = rand.Intn(2) == 0
+		ship.Horizontal = s.rng.Intn(2) == 0
 
 		var row, col int
 		if ship.Horizontal {
-			row = rand.Intn(entity.BoardSize)
-			col = rand.Intn(entity.BoardSize - ship.Size + 1)
+			row = s.rng.Intn(b.Size)
+			col = s.rng.Intn(b.Size - ship.Size + 1)
 		} else {
-			row = rand.Intn(entity.BoardSize - ship.Size + 1)
-			col = rand.Intn(entity.BoardSize)
+			row = s.rng.Intn(b.Size - ship.Size + 1)
+			col = s.rng.Intn(b.Size)
 		}
 
 		if b.PlaceShip(ship, row, col) {
@@ -73,3 +94,176 @@ func (s *AIFleetService) placeRandomShip(b *entity.Board, ship *entity.Ship) boo
 	}
 	return false
 }
+
+// policyAttempts é quantas vezes uma política tenta posicionar a frota inteira
+// antes de cair para o sorteio uniforme.
+const policyAttempts = 50
+
+// AIPlacement é como a IA posiciona a frota: a política do perfil e, para
+// ai.PlacementAntiHeatmap, o mapa de onde o adversário costuma atirar primeiro.
+type AIPlacement struct {
+	Policy ai.PlacementPolicy
+	Heat   [][]float64
+}
+
+// placementCandidate é uma posição possível de um navio, com a nota da política.
+type placementCandidate struct {
+	row, col   int
+	horizontal bool
+	score      float64
+}
+
+// PositionShips posiciona a frota seguindo a política de p. Cada navio é sorteado
+// entre as posições válidas com as melhores notas (o quarto superior), então a
+// frota fica bem escondida sem virar um padrão fixo. Se a política não conseguir
+// posicionar a frota, cai para PositionShipsRandomly.
+func (s *AIFleetService) PositionShips(b *entity.Board, f *entity.Fleet, p AIPlacement) {
+	if p.Policy == "" || p.Policy == ai.PlacementRandom {
+		s.PositionShipsRandomly(b, f)
+		return
+	}
+
+	order := placementOrder(f, p.Policy)
+	for attempt := 0; attempt < policyAttempts; attempt++ {
+		s.resetBoard(b)
+
+		var placed []entity.Cell
+		success := true
+		for i, ship := range order {
+			decoy := p.Policy == ai.PlacementDecoy && i == len(order)-1 && len(order) > 1
+			cells, ok := s.placeScoredShip(b, ship, func(cells []entity.Cell) float64 {
+				return scorePlacement(p, b.Size, cells, placed, decoy)
+			})
+			if !ok {
+				success = false
+				break
+			}
+			placed = append(placed, cells...)
+		}
+
+		if success {
+			return
+		}
+	}
+	s.PositionShipsRandomly(b, f)
+}
+
+// placementOrder devolve os navios na ordem de posicionamento. Na política de isca
+// o menor navio vai por último, longe do grupo formado pelos demais.
+func placementOrder(f *entity.Fleet, policy ai.PlacementPolicy) []*entity.Ship {
+	var order []*entity.Ship
+	for _, ship := range f.Ships {
+		if ship != nil {
+			order = append(order, ship)
+		}
+	}
+	if policy == ai.PlacementDecoy {
+		sort.SliceStable(order, func(i, j int) bool { return order[i].Size > order[j].Size })
+	}
+	return order
+}
+
+// placeScoredShip avalia todas as posições válidas de ship com score e coloca o
+// navio em uma delas, sorteada entre as melhores. Devolve as células ocupadas.
+func (s *AIFleetService) placeScoredShip(b *entity.Board, ship *entity.Ship, score func([]entity.Cell) float64) ([]entity.Cell, bool) {
+	var candidates []placementCandidate
+	for _, horizontal := range []bool{true, false} {
+		ship.Horizontal = horizontal
+		for row := 0; row < b.Size; row++ {
+			for col := 0; col < b.Size; col++ {
+				if !b.InBounds(row, col) || !b.CheckShipPosition(ship, row, col) {
+					continue
+				}
+				candidates = append(candidates, placementCandidate{
+					row: row, col: col, horizontal: horizontal,
+					score: score(shipCells(ship.Size, row, col, horizontal)),
+				})
+			}
+		}
+	}
+	if len(candidates) == 0 {
+		return nil, false
+	}
+
+	// embaralha antes de ordenar para o desempate não favorecer o canto superior esquerdo
+	s.rng.Shuffle(len(candidates), func(i, j int) { candidates[i], candidates[j] = candidates[j], candidates[i] })
+	sort.SliceStable(candidates, func(i, j int) bool { return candidates[i].score > candidates[j].score })
+
+	cut := candidates[len(candidates)/4].score
+	top := 0
+	for top < len(candidates) && candidates[top].score >= cut {
+		top++
+	}
+
+	for _, c := range candidates[:top] {
+		ship.Horizontal = c.horizontal
+		if b.PlaceShip(ship, c.row, c.col) {
+			return shipCells(ship.Size, c.row, c.col, c.horizontal), true
+		}
+	}
+	return nil, false
+}
+
+// shipCells devolve as células de um navio de tamanho size com início em (row, col).
+func shipCells(size, row, col int, horizontal bool) []entity.Cell {
+	cells := make([]entity.Cell, size)
+	for k := range cells {
+		if horizontal {
+			cells[k] = entity.Cell{Row: row, Col: col + k}
+		} else {
+			cells[k] = entity.Cell{Row: row + k, Col: col}
+		}
+	}
+	return cells
+}
+
+// scorePlacement dá a nota de um navio nas células cells (maior é melhor), dadas as
+// células dos navios já posicionados. decoy marca a isca da política PlacementDecoy.
+func scorePlacement(p AIPlacement, size int, cells, placed []entity.Cell, decoy bool) float64 {
+	score := 0.0
+	switch p.Policy {
+	case ai.PlacementEdges:
+		// quanto mais perto da borda, melhor
+		for _, c := range cells {
+			score -= float64(min(c.Row, c.Col, size-1-c.Row, size-1-c.Col))
+		}
+	case ai.PlacementSpread:
+		score = float64(minCellDistance(cells, placed))
+	case ai.PlacementAntiHeatmap:
+		for _, c := range cells {
+			if c.Row < len(p.Heat) && c.Col < len(p.Heat[c.Row]) {
+				score -= p.Heat[c.Row][c.Col]
+			}
+		}
+	case ai.PlacementDecoy:
+		if decoy {
+			score = float64(minCellDistance(cells, placed))
+			break
+		}
+		// o grupo: cada navio o mais perto possível dos já posicionados
+		score = -float64(minCellDistance(cells, placed))
+	}
+	return score
+}
+
+// minCellDistance devolve a menor distância de Manhattan entre cells e placed
+// (0 se ainda não há navio posicionado).
+func minCellDistance(cells, placed []entity.Cell) int {
+	best := -1
+	for _, c := range cells {
+		for _, o := range placed {
+			d := abs(c.Row-o.Row) + abs(c.Col-o.Col)
+			if best == -1 || d < best {
+				best = d
+			}
+		}
+	}
+	return max(best, 0)
+}
+
+func abs(x int) int {
+	if x < 0 {
+		return -x
+	}
+	return x
+}
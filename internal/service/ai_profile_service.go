@@ -0,0 +1,112 @@
+package service
+
+import (
+	"fmt"
+	"io/fs"
+	"os"
+	"sync"
+	"time"
+
+	battleship "github.com/allanjose001/go-battleship"
+	"github.com/allanjose001/go-battleship/internal/ai"
+	"github.com/allanjose001/go-battleship/internal/entity"
+)
+
+// aiProfilesDir guarda perfis de IA extras em JSON (um por arquivo), embutidos
+// no binário (battleship.Assets).
+const aiProfilesDir string = "assets/ai_profiles"
+
+// customAIProfilesDir é onde o jogador põe perfis de IA próprios, dentro do
+// diretório de dados (ver DataDir): entram no jogo sem recompilar.
+const customAIProfilesDir string = "ai_profiles"
+
+var (
+	aiProfiles     []ai.Profile
+	aiProfilesOnce sync.Once
+)
+
+// AvailableAIProfiles retorna os perfis embutidos seguidos dos carregados de
+// aiProfilesDir e de customAIProfilesDir (ver loadJSONAIProfiles).
+// Arquivos inválidos são ignorados para não impedir o jogo de continuar.
+func AvailableAIProfiles() []ai.Profile {
+	aiProfilesOnce.Do(func() {
+		aiProfiles = ai.BuiltinProfiles()
+
+		for _, p := range loadJSONAIProfiles() {
+			if _, exists := findAIProfile(aiProfiles, p.ID); exists {
+				continue // embutidos têm prioridade
+			}
+			aiProfiles = append(aiProfiles, p)
+		}
+	})
+	return aiProfiles
+}
+
+// CustomAIProfiles retorna só os perfis carregados de JSON.
+func CustomAIProfiles() []ai.Profile {
+	return AvailableAIProfiles()[len(ai.BuiltinProfiles()):]
+}
+
+// FindAIProfile procura um perfil de IA pelo id.
+func FindAIProfile(id string) (ai.Profile, bool) {
+	return findAIProfile(AvailableAIProfiles(), id)
+}
+
+func findAIProfile(profiles []ai.Profile, id string) (ai.Profile, bool) {
+	for _, p := range profiles {
+		if p.ID == id {
+			return p, true
+		}
+	}
+	return ai.Profile{}, false
+}
+
+// ResolveAIProfile devolve o perfil da dificuldade, caindo para o fácil se ele não existir.
+func ResolveAIProfile(difficulty string) ai.Profile {
+	if p, ok := FindAIProfile(difficulty); ok {
+		return p
+	}
+	fmt.Printf("Perfil de IA %q não encontrado (usando %s)\n", difficulty, ai.EasyProfile.ID)
+	return ai.EasyProfile
+}
+
+// AIThinkDelay devolve a pausa antes de cada jogada da IA da dificuldade contra player.
+func AIThinkDelay(difficulty string, player *entity.Profile) time.Duration {
+	return ResolvePlayerAIProfile(difficulty, player).ThinkDelay()
+}
+
+// loadJSONAIProfiles carrega os perfis em JSON embutidos (aiProfilesDir) e os
+// do diretório de dados (customAIProfilesDir); um arquivo do disco substitui o
+// embutido de mesmo id.
+func loadJSONAIProfiles() []ai.Profile {
+	profiles, err := LoadAIProfilesDir(battleship.Assets, aiProfilesDir)
+	if err != nil {
+		fmt.Println("Erro carregando perfis de IA:", err)
+	}
+
+	custom, err := LoadAIProfilesDir(os.DirFS(DataDir()), customAIProfilesDir)
+	if err != nil {
+		fmt.Printf("Erro carregando perfis de IA de %s: %v\n", DataDir(), err)
+	}
+	return overrideByID(profiles, custom, func(p ai.Profile) string { return p.ID })
+}
+
+// LoadAIProfileFile lê um perfil de IA de um arquivo JSON em fsys
+// (os.DirFS para ler do disco).
+func LoadAIProfileFile(fsys fs.FS, name string) (ai.Profile, error) {
+	data, err := fs.ReadFile(fsys, name)
+	if err != nil {
+		return ai.Profile{}, err
+	}
+
+	p, err := ai.ParseProfile(data)
+	if err != nil {
+		return ai.Profile{}, fmt.Errorf("%s: %w", name, err)
+	}
+	return p, nil
+}
+
+// LoadAIProfilesDir carrega os perfis de IA de dir em fsys (ver loadJSONDir).
+func LoadAIProfilesDir(fsys fs.FS, dir string) ([]ai.Profile, error) {
+	return loadJSONDir(fsys, dir, LoadAIProfileFile)
+}
@@ -1,11 +1,10 @@
 // AttackService: concentra a regra de combate.
-// Responsável por aplicar ataques do jogador no board visual da IA
-// e executar o turno da IA sincronizando o entity.Board com o board
-// visual do jogador. Não orquestra turnos (isso é do BattleService).
+// Responsável por aplicar ataques no entity.Board (fonte da verdade dos
+// tabuleiros) e contabilizar tentativas/acertos. O renderer apenas lê
+// esse mesmo entity.Board. Não orquestra turnos (isso é do BattleService).
 package service
 
 import (
-	"github.com/allanjose001/go-battleship/game/shared/board"
 	"github.com/allanjose001/go-battleship/internal/ai"
 	"github.com/allanjose001/go-battleship/internal/entity"
 )
@@ -17,69 +16,47 @@ func NewAttackService() *AttackService {
 }
 
 // PlayerAttack:
-// - Ignora células já atacadas
+// - Ignora células já atacadas ou fora do tabuleiro
 // - Conta tentativa
-// - Marca Hit/Miss conforme estado da célula
-// - Atualiza o entityBoard da IA (contabiliza dano nos navios)
+// - Aplica o ataque no entityBoard da IA (contabiliza dano nos navios)
 // - Retorna indicadores de acerto e fim de jogo usando totalShipCells
-func (s *AttackService) PlayerAttack(aiBoard *board.Board, aiEntityBoard *entity.Board, row, col int, attempts, hits, totalShipCells int) (int, int, bool, bool) {
-	cell := &aiBoard.Cells[row][col]
-
-	if cell.State == board.Hit || cell.State == board.Miss {
+func (s *AttackService) PlayerAttack(aiEntityBoard *entity.Board, row, col int, attempts, hits, totalShipCells int) (int, int, bool, bool) {
+	if aiEntityBoard == nil || !aiEntityBoard.CheckPosition(row, col) {
 		return attempts, hits, false, false
 	}
 
 	attempts++
 
-	if cell.State == board.Ship {
-		// Registra o ataque na entidade lógica (navio) para atualizar contadores de dano
-		if aiEntityBoard != nil {
-			aiEntityBoard.AttackPositionA(row, col)
-		}
-
-		cell.State = board.Hit
-		hits++
-		if hits >= totalShipCells {
-			return attempts, hits, true, true
-		}
-		return attempts, hits, true, false
-	}
-
-	if cell.State == board.Empty {
-		cell.State = board.Miss
+	if aiEntityBoard.AttackPositionB(row, col) == nil {
+		return attempts, hits, false, false
 	}
 
-	return attempts, hits, false, false
+	hits++
+	return attempts, hits, true, hits >= totalShipCells
 }
 
 // AITurn:
-// - Pede para o AIPlayer atacar o entity.Board do jogador
-// - Sincroniza esse ataque com o board visual (marcando Hit/Miss)
+// - Pede para o AIPlayer atacar o entity.Board do jogador, visto só pelo oráculo de tiros
+// - Identifica o tiro aplicado pelo contador de tiros do board
 // - Checa fim de jogo com totalShipCells
-func (s *AttackService) AITurn(aiPlayer *ai.AIPlayer, entityBoard *entity.Board, playerBoard *board.Board, attempts, hits, totalShipCells int) (int, int, bool) {
-	if aiPlayer == nil {
+func (s *AttackService) AITurn(aiPlayer *ai.AIPlayer, entityBoard *entity.Board, attempts, hits, totalShipCells int) (int, int, bool) {
+	if aiPlayer == nil || entityBoard == nil {
 		return attempts, hits, false
 	}
 
 	attempts++
-	aiPlayer.Attack(entityBoard)
 
-	for r := 0; r < board.Rows; r++ {
-		for c := 0; c < board.Cols; c++ {
-			entPos := entityBoard.Positions[r][c]
-			cell := &playerBoard.Cells[r][c]
+	prevShots := entityBoard.ShotCount()
+	aiPlayer.Attack(ai.NewBoardOracle(entityBoard))
+	if entityBoard.ShotCount() == prevShots {
+		return attempts, hits, false
+	}
 
-			if entity.IsAttacked(entPos) && cell.State != board.Hit && cell.State != board.Miss {
-				if cell.State == board.Ship {
-					cell.State = board.Hit
-					hits++
-					if hits >= totalShipCells {
-						return attempts, hits, true
-					}
-				} else {
-					cell.State = board.Miss
-				}
-			}
+	row, col := entityBoard.LastShot()
+	if entity.WasHit(entityBoard.Positions[row][col]) {
+		hits++
+		if hits >= totalShipCells {
+			return attempts, hits, true
 		}
 	}
 
@@ -1,9 +1,9 @@
 package service
 
 import (
+	"fmt"
 	"time"
 
-	"github.com/allanjose001/go-battleship/game/scenes/audio"
 	"github.com/allanjose001/go-battleship/internal/ai"
 	"github.com/allanjose001/go-battleship/internal/entity"
 )
@@ -20,6 +20,11 @@ type BattleService interface {
 	Stats() (playerShots, playerHits, enemyShots, enemyHits int, isPlayerTurn bool)
 	// WinnerName retorna o nome do vencedor caso a partida tenha terminado.
 	WinnerName() string
+	// SalvoStatus retorna quantos alvos o jogador já marcou e quantos tiros tem na salva
+	// atual (0, 0 fora do modo Salvo).
+	SalvoStatus() (selected, shots int)
+	// AIDebug retorna o que a IA sabe do tabuleiro do jogador (overlay de debug).
+	AIDebug() ai.DebugInfo
 }
 
 // battleService é a implementação concreta da interface BattleService.
@@ -33,55 +38,55 @@ type battleService struct {
 	aiPlayer *ai.AIPlayer
 	// profile é o perfil do jogador humano, usado para registrar estatísticas de vitória/derrota.
 	profile *entity.Profile
+	// profiles grava o perfil no fim da partida.
+	profiles *ProfileService
 
-	SoundService *audio.SoundService
+	SoundService SoundPlayer
 
 	isCampaign bool
+
+	// onPlayerShots recebe os tiros do jogador já aplicados (o modo dinâmico
+	// avisa a IA dos acertos nos navios dela).
+	onPlayerShots func(events ...entity.AttackEvent)
+	// enemyMoves conta os turnos em que a IA moveu um navio em vez de atirar.
+	enemyMoves int
 }
 
 // NewBattleServiceFromMatch inicializa o serviço a partir de um Match existente no contexto.
-// Se o Match ainda não foi inicializado (runtime), ele configura a IA e inicia o jogo.
-func NewBattleServiceFromMatch(match *entity.Match, isCampaign bool, ss *audio.SoundService) (BattleService, error) {
-	setupSvc := NewBattleSetupService()
-	matchSvc := NewMatchService(nil, 500*time.Millisecond, ss)
-
+// Os boards/frotas lógicos dos dois lados já devem estar no Match (montados no placement).
+// Se o Match ainda não foi iniciado, ele configura a IA e inicia o jogo.
+func NewBattleServiceFromMatch(match *entity.Match, isCampaign bool, ss SoundPlayer, profiles *ProfileService) (BattleService, error) {
 	var aiPlayer *ai.AIPlayer
 
-	if match.PlayerEntityBoard == nil {
-		// Constrói a lógica do player
-		playerEntityBoard, playerFleet := setupSvc.BuildEntityBoard(match.PlayerShips)
+	if match == nil {
+		return nil, ErrMatchNotFound
+	}
+	if match.PlayerEntityBoard == nil || match.EnemyEntityBoard == nil {
+		return nil, ErrMatchNotReady
+	}
+	if profiles == nil {
+		profiles = NewProfileService(nil) // sem repositório: o perfil não é gravado
+	}
+
+	// a dificuldade adaptativa depende do perfil do jogador
+	setupSvc := NewBattleSetupService().ForPlayer(match.Profile)
+	matchSvc := NewMatchService(nil, AIThinkDelay(match.Difficulty, match.Profile), ss)
 
-		// Constrói a lógica do inimigo (usando os navios da IA!)
-		enemyEntityBoard, enemyFleet := setupSvc.BuildEntityBoard(match.EnemyShips)
+	if match.Status == entity.MatchStatusWaiting {
+		playerEntityBoard, playerFleet := match.PlayerEntityBoard, match.PlayerFleet
+		enemyEntityBoard, enemyFleet := match.EnemyEntityBoard, match.EnemyFleet
 
 		// Inicializa a IA passando a frota do jogador (para ela saber o que atacar)
-		aiPlayer = setupSvc.InitBattleAI(match.Difficulty, playerFleet)
-
-		// Preenche os campos no Match
-		match.PlayerEntityBoard = playerEntityBoard
-		match.PlayerFleet = playerFleet
-		match.EnemyEntityBoard = enemyEntityBoard
-		match.EnemyFleet = enemyFleet
-
-		totalPlayerCells := 0
-		for _, ship := range match.PlayerShips {
-			if ship != nil {
-				totalPlayerCells += ship.Size
-			}
-		}
+		aiPlayer = setupSvc.InitBattleAI(match.Difficulty, playerFleet, match.BoardSize, match.Rand())
+		ApplyPlacementHabits(aiPlayer, match.Profile, match.BoardSize)
 
-		totalEnemyCells := 0
-		for _, ship := range match.EnemyShips {
-			if ship != nil {
-				totalEnemyCells += ship.Size
-			}
-		}
+		// as duas frotas seguem a mesma composição da partida
+		totalPlayerCells := match.FleetSpec.TotalCells()
+		totalEnemyCells := match.FleetSpec.TotalCells()
 
 		if err := matchSvc.Start(
 			match,
 			time.Now(),
-			match.PlayerBoard,
-			match.EnemyBoard,
 			playerEntityBoard,
 			enemyEntityBoard,
 			playerFleet,
@@ -92,16 +97,8 @@ func NewBattleServiceFromMatch(match *entity.Match, isCampaign bool, ss *audio.S
 			return nil, err
 		}
 	} else {
-		switch match.Difficulty {
-		case "easy":
-			aiPlayer = ai.NewEasyAIPlayer()
-		case "medium":
-			aiPlayer = ai.NewMediumAIPlayer(match.PlayerFleet)
-		case "hard":
-			aiPlayer = ai.NewHardAIPlayer(match.PlayerFleet)
-		default:
-			aiPlayer = ai.NewEasyAIPlayer()
-		}
+		aiPlayer = setupSvc.InitBattleAI(match.Difficulty, match.PlayerFleet, match.BoardSize, match.Rand())
+		ApplyPlacementHabits(aiPlayer, match.Profile, match.BoardSize)
 	}
 
 	return &battleService{
@@ -109,6 +106,7 @@ func NewBattleServiceFromMatch(match *entity.Match, isCampaign bool, ss *audio.S
 		match:        match,
 		aiPlayer:     aiPlayer,
 		profile:      match.Profile,
+		profiles:     profiles,
 		isCampaign:   isCampaign,
 		SoundService: ss,
 	}, nil
@@ -121,36 +119,56 @@ func (s *battleService) HandlePlayerClick(row, col int) (*entity.MatchResult, er
 		return nil, ErrMatchNotReady
 	}
 
+	if s.match.IsSalvoMode {
+		return s.handlePlayerSalvoClick(row, col)
+	}
+
 	// Solicita ao serviço de domínio que processe o ataque do jogador.
 	ev, err := s.matchSvc.PlayerAttack(s.match, time.Now(), row, col)
 	if err != nil {
 		return nil, err
 	}
+	s.notifyPlayerShots(ev)
 
 	// Se o ataque resultou em Game Over, processa o fim de jogo.
 	if ev.GameOver {
-		res := s.matchSvc.ResultForPlayer(s.match)
-		// Salva a dificuldade da partida
-		res.Difficulty = s.match.Difficulty
-		// Salva o modo da partida
-		if s.match.IsDynamicMode {
-			res.Mode = "Dinâmico"
-		} else if s.isCampaign {
-			res.Mode = "Campanha"
-		} else {
-			res.Mode = "Clássica"
-		}
-		// Registra o resultado no perfil do jogador (se existir).
-		if s.profile != nil && !s.isCampaign {
-			_, _ = AddMatchToProfile(s.profile, res)
-		}
-		return &res, nil
+		return s.endMatch(), nil
 	}
 
 	// Retorna nil se o jogo continua.
+	s.saveProgress()
 	return nil, nil
 }
 
+// handlePlayerSalvoClick marca/desmarca o alvo clicado e dispara a salva
+// quando o jogador completa todos os tiros do turno.
+func (s *battleService) handlePlayerSalvoClick(row, col int) (*entity.MatchResult, error) {
+	ready, err := s.matchSvc.TogglePendingShot(s.match, row, col)
+	if err != nil || !ready {
+		return nil, err
+	}
+
+	events, err := s.matchSvc.PlayerSalvo(s.match, time.Now(), s.match.PendingShots)
+	if err != nil {
+		return nil, err
+	}
+	s.notifyPlayerShots(events...)
+
+	if len(events) > 0 && events[len(events)-1].GameOver {
+		return s.endMatch(), nil
+	}
+
+	s.saveProgress()
+	return nil, nil
+}
+
+// notifyPlayerShots repassa os tiros do jogador para onPlayerShots, se houver.
+func (s *battleService) notifyPlayerShots(events ...entity.AttackEvent) {
+	if s.onPlayerShots != nil {
+		s.onPlayerShots(events...)
+	}
+}
+
 // HandleEnemyTurn executa a lógica de ataque da IA.
 func (s *battleService) HandleEnemyTurn() (*entity.MatchResult, error) {
 	// Verifica pré-condições.
@@ -159,7 +177,18 @@ func (s *battleService) HandleEnemyTurn() (*entity.MatchResult, error) {
 	}
 
 	// Executa um passo da IA (pode não fazer nada se não for a vez dela ou se estiver em delay).
-	ev, err := s.matchSvc.EnemyAttackStep(s.match, time.Now(), s.aiPlayer)
+	// No modo Salvo o passo é a salva inteira da IA.
+	var ev entity.AttackEvent
+	var err error
+	if s.match.IsSalvoMode {
+		var events []entity.AttackEvent
+		events, err = s.matchSvc.EnemySalvo(s.match, time.Now(), s.aiPlayer)
+		if len(events) > 0 {
+			ev = events[len(events)-1]
+		}
+	} else {
+		ev, err = s.matchSvc.EnemyAttackStep(s.match, time.Now(), s.aiPlayer)
+	}
 	if err != nil {
 		// Ignora erros esperados que indicam que a IA ainda não deve agir.
 		if err == ErrActionNotReady ||
@@ -170,27 +199,111 @@ func (s *battleService) HandleEnemyTurn() (*entity.MatchResult, error) {
 		}
 		return nil, err
 	}
+	if ev.Moved {
+		s.enemyMoves++
+	}
 
 	// Se a IA venceu, processa o fim de jogo.
 	if ev.GameOver {
-		res := s.matchSvc.ResultForPlayer(s.match)
-		res.Difficulty = s.match.Difficulty
-
-		if s.match.IsDynamicMode {
-			res.Mode = "Dinâmico"
-		} else if s.isCampaign {
-			res.Mode = "Campanha"
-		} else {
-			res.Mode = "Clássica"
-		}
+		return s.endMatch(), nil
+	}
+
+	s.saveProgress()
+	return nil, nil
+}
+
+// endMatch monta o MatchResult da partida encerrada, salva o replay,
+// apaga o save de partida em andamento e registra o resultado no perfil.
+func (s *battleService) endMatch() *entity.MatchResult {
+	res := s.matchSvc.ResultForPlayer(s.match)
+	// Salva a dificuldade e o modo da partida
+	res.Difficulty = s.match.Difficulty
+	res.Mode = s.resultMode()
+
+	s.saveReplay(&res)
+	s.clearProgress()
 
-		if s.profile != nil && !s.isCampaign {
-			_, _ = AddMatchToProfile(s.profile, res)
+	// Registra o resultado e o posicionamento (IA adaptativa) no perfil do jogador (se existir).
+	if s.profile != nil && !s.isCampaign {
+		RecordPlacementHabits(s.profile, s.match)
+		if s.match.Difficulty == AdaptiveDifficulty {
+			UpdateAdaptiveRating(s.profile, res.Win)
+		}
+		_, _ = s.profiles.AddMatch(s.profile, res)
+	} else if s.profile != nil {
+		if err := s.profiles.LearnPlacementHabits(s.profile.Username, s.match); err != nil {
+			fmt.Println("Erro salvando hábitos de posicionamento:", err)
 		}
-		return &res, nil
 	}
+	return &res
+}
 
-	return nil, nil
+// resultMode devolve o nome do modo gravado em MatchResult.Mode.
+func (s *battleService) resultMode() string {
+	switch {
+	case s.match.IsDynamicMode:
+		return "Dinâmico"
+	case s.isCampaign:
+		return "Campanha"
+	case s.match.IsSalvoMode:
+		return "Salvo"
+	default:
+		return "Clássica"
+	}
+}
+
+// SalvoStatus retorna os alvos marcados e os tiros da salva atual do jogador.
+func (s *battleService) SalvoStatus() (selected, shots int) {
+	if s.match == nil || !s.match.IsSalvoMode {
+		return 0, 0
+	}
+	return len(s.match.PendingShots), s.matchSvc.SalvoShots(s.match, entity.TurnPlayer)
+}
+
+// AIDebug retorna uma fotografia do raciocínio da IA adversária.
+func (s *battleService) AIDebug() ai.DebugInfo {
+	if s.aiPlayer == nil {
+		return ai.DebugInfo{}
+	}
+	return s.aiPlayer.Debug()
+}
+
+// saveProgress salva a partida em andamento do perfil para ela poder ser
+// retomada depois de fechar o jogo. Partidas de campanha não são salvas.
+func (s *battleService) saveProgress() {
+	if s.profile == nil || s.isCampaign || s.match.IsFinished() {
+		return
+	}
+	save, err := SnapshotMatch(s.match, s.aiPlayer, s.profile.Username, time.Now())
+	if err == nil {
+		err = SaveMatchInProgress(save)
+	}
+	if err != nil {
+		fmt.Println("Erro salvando partida em andamento:", err)
+	}
+}
+
+// clearProgress apaga a partida salva quando ela termina.
+func (s *battleService) clearProgress() {
+	if s.profile == nil || s.isCampaign {
+		return
+	}
+	if err := DeleteMatchInProgress(s.profile.Username); err != nil {
+		fmt.Println("Erro apagando partida em andamento:", err)
+	}
+}
+
+// saveReplay grava o log da partida encerrada e vincula o ReplayID ao resultado.
+// Falha ao salvar não impede o registro do resultado (a partida só fica sem replay).
+func (s *battleService) saveReplay(res *entity.MatchResult) {
+	if s.match == nil || s.match.Record == nil {
+		return
+	}
+	if err := SaveMatchRecord(s.match.Record); err != nil {
+		fmt.Println("Erro salvando replay:", err)
+		return
+	}
+	res.ReplayID = s.match.Record.ID
 }
 
 // Stats retorna um resumo do estado atual da partida para exibição no HUD.
@@ -2,70 +2,51 @@ package service
 
 import (
 	"fmt"
+	"math/rand"
 	"reflect"
 
-	"github.com/allanjose001/go-battleship/game/shared/board"
-	"github.com/allanjose001/go-battleship/game/shared/placement"
 	"github.com/allanjose001/go-battleship/internal/ai"
 	"github.com/allanjose001/go-battleship/internal/entity"
 )
 
-type BattleSetupService struct{}
+type BattleSetupService struct {
+	player *entity.Profile // adversário da IA (usado pela dificuldade adaptativa)
+}
 
 func NewBattleSetupService() *BattleSetupService {
 	return &BattleSetupService{}
 }
 
+// ForPlayer informa o perfil do jogador que a IA vai enfrentar.
+func (s *BattleSetupService) ForPlayer(player *entity.Profile) *BattleSetupService {
+	s.player = player
+	return s
+}
+
 // InitBattleAI inicializa a inteligência artificial com base na dificuldade selecionada.
 // Parâmetros:
-// - difficulty: string que define o nível ("easy", "medium", "hard")
+// - difficulty: id do perfil de IA ("easy", "medium", "hard", "expert", "adaptive"
+// ou um perfil carregado de assets/ai_profiles)
 // - playerFleet: a frota do jogador (para a IA saber o que atacar)
-func (s *BattleSetupService) InitBattleAI(difficulty string, playerFleet *entity.Fleet) *ai.AIPlayer {
-	var aiPlayer *ai.AIPlayer
+// - boardSize: dimensão do tabuleiro da partida
+// - rng: gerador da partida (entity.Match.Rand), para a IA ser reproduzível pela seed
+func (s *BattleSetupService) InitBattleAI(difficulty string, playerFleet *entity.Fleet, boardSize int, rng *rand.Rand) *ai.AIPlayer {
+	return s.InitBattleAIWithBoard(difficulty, playerFleet, boardSize, nil, rng)
+}
 
+// InitBattleAIWithBoard é como InitBattleAI, mas entrega à IA o próprio tabuleiro
+// (aiBoard), usado pelos perfis que movem navios.
+func (s *BattleSetupService) InitBattleAIWithBoard(difficulty string, playerFleet *entity.Fleet, boardSize int, aiBoard *entity.Board, rng *rand.Rand) *ai.AIPlayer {
 	fmt.Printf("Iniciando batalha com dificuldade: %s\n", difficulty)
 
-	switch difficulty {
-	case "easy":
-		aiPlayer = ai.NewEasyAIPlayer()
-	case "medium":
-		aiPlayer = ai.NewMediumAIPlayer(playerFleet)
-	case "hard":
-		aiPlayer = ai.NewHardAIPlayer(playerFleet)
-	default:
-		aiPlayer = ai.NewEasyAIPlayer()
+	profile := ResolvePlayerAIProfile(difficulty, s.player)
+	aiPlayer, err := profile.Build(playerFleet, boardSize, aiBoard)
+	if err != nil {
+		fmt.Println("Erro criando IA:", err)
+		aiPlayer = ai.NewEasyAIPlayer(boardSize)
 	}
-	fmt.Printf("AI Player Instanciado: %v\n", reflect.TypeOf(aiPlayer))
+	aiPlayer.SetRand(rng)
+	fmt.Printf("AI Player Instanciado: %s (%v)\n", profile.Name, reflect.TypeOf(aiPlayer))
 
 	return aiPlayer
 }
-
-// BuildEntityBoard constrói a representação lógica do tabuleiro e da frota
-// a partir dos navios posicionados visualmente.
-func (s *BattleSetupService) BuildEntityBoard(ships []*placement.ShipPlacement) (*entity.Board, *entity.Fleet) {
-	fleet := entity.NewFleet()
-	entityBoard := &entity.Board{}
-
-	usedShips := make(map[int]bool)
-
-	for _, ps := range ships {
-		if !ps.Placed {
-			continue
-		}
-
-		var entShip *entity.Ship
-		for i, ship := range fleet.Ships {
-			if !usedShips[i] && ship.Size == ps.Size {
-				entShip = ship
-				usedShips[i] = true
-				break
-			}
-		}
-
-		if entShip != nil {
-			entShip.Horizontal = ps.Orientation == board.Horizontal
-			entityBoard.PlaceShip(entShip, ps.Y, ps.X)
-		}
-	}
-	return entityBoard, fleet
-}
@@ -4,7 +4,6 @@ import (
 	"fmt"
 	"time"
 
-	"github.com/allanjose001/go-battleship/game/shared/board"
 	"github.com/allanjose001/go-battleship/internal/ai"
 	"github.com/allanjose001/go-battleship/internal/entity"
 )
@@ -12,11 +11,13 @@ import (
 // CampaignService gerencia o fluxo do modo campanha.
 type CampaignService struct {
 	matchService *MatchService
+	profiles     *ProfileService
 }
 
-func NewCampaignService(ms *MatchService) *CampaignService {
+func NewCampaignService(ms *MatchService, profiles *ProfileService) *CampaignService {
 	return &CampaignService{
 		matchService: ms,
+		profiles:     profiles,
 	}
 }
 
@@ -24,15 +25,13 @@ func NewCampaignService(ms *MatchService) *CampaignService {
 func (cs *CampaignService) StartCampaignMatch(
 	username string,
 	fleet *entity.Fleet,
-	playerBoard *board.Board,
-	enemyBoard *board.Board,
 	playerEntityBoard *entity.Board,
 	enemyEntityBoard *entity.Board,
 	enemyFleet *entity.Fleet,
 	enemyShipCells int,
 	playerShipCells int,
 ) (*ai.AIPlayer, error) {
-	profile, err := FindProfile(username)
+	profile, err := cs.profiles.Find(username)
 	if err != nil {
 		return nil, err
 	}
@@ -52,18 +51,18 @@ func (cs *CampaignService) StartCampaignMatch(
 		return nil, fmt.Errorf("campanha para %s já foi concluída", username)
 	}
 
-	// Cria a IA correspondente ao nível atual
-	opponent := cs.selectAI(diff, fleet)
-
 	// Cria o objeto de partida em memória
-	match := cs.matchService.Create(profile.CurrentCampaign.ID, diff)
+	match := cs.matchService.Create(profile.CurrentCampaign.ID, diff, playerEntityBoard.Size)
+
+	// Cria a IA correspondente ao nível atual, com o gerador da partida
+	opponent := cs.selectAI(diff, fleet, playerEntityBoard.Size)
+	opponent.SetRand(match.Rand())
+	ApplyPlacementHabits(opponent, profile, playerEntityBoard.Size)
 
 	// Inicia os estados da partida (Turnos, Timers, etc)
 	err = cs.matchService.Start(
 		match,
 		time.Now(),
-		playerBoard,
-		enemyBoard,
 		playerEntityBoard,
 		enemyEntityBoard,
 		fleet,
@@ -81,7 +80,7 @@ func (cs *CampaignService) StartCampaignMatch(
 
 // HandleCampaignResult processa o fim da partida
 func (cs *CampaignService) HandleCampaignResult(username string, diff string, currentMatchResult *entity.MatchResult, playerWins, enemyWins int) (*entity.MatchResult, bool, error) {
-	profile, err := FindProfile(username)
+	profile, err := cs.profiles.Find(username)
 	if err != nil {
 		return nil, false, err
 	}
@@ -104,6 +103,7 @@ func (cs *CampaignService) HandleCampaignResult(username string, diff string, cu
 			accumulated.Hits += currentMatchResult.Hits
 			accumulated.LostShips += currentMatchResult.LostShips
 			accumulated.KilledShips += currentMatchResult.KilledShips
+			accumulated.FleetShips += currentMatchResult.FleetShips
 			accumulated.Duration += currentMatchResult.Duration
 			accumulated.Score += currentMatchResult.Score
 			if currentMatchResult.HigherHitSequence > accumulated.HigherHitSequence {
@@ -116,7 +116,7 @@ func (cs *CampaignService) HandleCampaignResult(username string, diff string, cu
 
 	// Salva o estado intermediário (acumulado) no perfil
 	profile.CurrentCampaign.DifficultyStep[diff] = accumulated
-	if err := UpdateProfile(*profile); err != nil {
+	if err := cs.profiles.Save(*profile); err != nil {
 		return nil, false, err
 	}
 
@@ -136,7 +136,7 @@ func (cs *CampaignService) HandleCampaignResult(username string, diff string, cu
 	profile.CurrentCampaign.DifficultyStep[diff] = finalRes
 
 	// 5. Persistência no histórico
-	_, err = AddMatchToProfile(profile, finalRes)
+	_, err = cs.profiles.AddMatch(profile, finalRes)
 	return &finalRes, true, err
 }
 
@@ -145,7 +145,7 @@ func (cs *CampaignService) GetNextDifficulty(c *entity.Campaign) (string, bool)
 	if c.DifficultyStep == nil {
 		return "easy", false
 	}
-	steps := []string{"easy", "medium", "hard"}
+	steps := []string{"easy", "medium", "hard", "expert"}
 	for _, step := range steps {
 		res, ok := c.DifficultyStep[step]
 		if !ok || !res.Win {
@@ -156,13 +156,6 @@ func (cs *CampaignService) GetNextDifficulty(c *entity.Campaign) (string, bool)
 }
 
 // selectAI instancia a IA correta para o nível.
-func (cs *CampaignService) selectAI(diff string, fleet *entity.Fleet) *ai.AIPlayer {
-	switch diff {
-	case "medium":
-		return ai.NewMediumAIPlayer(fleet)
-	case "hard":
-		return ai.NewHardAIPlayer(fleet)
-	default:
-		return ai.NewEasyAIPlayer()
-	}
+func (cs *CampaignService) selectAI(diff string, fleet *entity.Fleet, boardSize int) *ai.AIPlayer {
+	return NewBattleSetupService().InitBattleAI(diff, fleet, boardSize, nil)
 }
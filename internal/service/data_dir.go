@@ -0,0 +1,86 @@
+package service
+
+import (
+	"io/fs"
+	"path"
+	"path/filepath"
+	"sort"
+)
+
+// LegacyDataDir é o diretório de dados de antes do diretório por usuário,
+// relativo à raiz do repositório. Continua sendo o padrão de quem não chama
+// SetDataDir (ferramentas rodadas da raiz).
+const LegacyDataDir string = "internal/data"
+
+// dataDir é onde ficam perfis, partidas salvas e replays.
+var dataDir = LegacyDataDir
+
+// SetDataDir troca o diretório de dados do jogo. Deve ser chamado na
+// inicialização, antes de abrir perfis ou salvar partidas.
+func SetDataDir(dir string) {
+	dataDir = dir
+}
+
+// DataDir devolve o diretório de dados do jogo.
+func DataDir() string {
+	return dataDir
+}
+
+// ProfilesPath é o arquivo de perfis do jogo, dentro do diretório de dados.
+func ProfilesPath() string {
+	return filepath.Join(dataDir, "profiles.json")
+}
+
+// replaysDir guarda um log por partida gravada (ver SaveMatchRecord).
+func replaysDir() string {
+	return filepath.Join(dataDir, "replays")
+}
+
+// savesDir guarda a partida em andamento de cada perfil (ver SaveMatchInProgress).
+func savesDir() string {
+	return filepath.Join(dataDir, "saves")
+}
+
+// overrideByID junta base e extra: um item de extra substitui, na mesma
+// posição, o de base com o mesmo id; os demais vão para o fim.
+func overrideByID[T any](base, extra []T, id func(T) string) []T {
+	merged := append([]T(nil), base...)
+	index := make(map[string]int, len(merged))
+	for i, item := range merged {
+		index[id(item)] = i
+	}
+	for _, item := range extra {
+		if i, ok := index[id(item)]; ok {
+			merged[i] = item
+			continue
+		}
+		index[id(item)] = len(merged)
+		merged = append(merged, item)
+	}
+	return merged
+}
+
+// loadJSONDir lê com load todos os *.json de dir em fsys, em ordem alfabética.
+// Um arquivo com erro é pulado e o primeiro erro é devolvido junto com o que
+// carregou; diretório inexistente não é erro (retorna lista vazia).
+func loadJSONDir[T any](fsys fs.FS, dir string, load func(fs.FS, string) (T, error)) ([]T, error) {
+	paths, err := fs.Glob(fsys, path.Join(dir, "*.json"))
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(paths)
+
+	var items []T
+	var firstErr error
+	for _, p := range paths {
+		item, err := load(fsys, p)
+		if err != nil {
+			if firstErr == nil {
+				firstErr = err
+			}
+			continue
+		}
+		items = append(items, item)
+	}
+	return items, firstErr
+}
@@ -1,11 +1,8 @@
 package service
 
 import (
-	"fmt"
 	"time"
 
-	"github.com/allanjose001/go-battleship/game/scenes/audio"
-	"github.com/allanjose001/go-battleship/game/shared/board"
 	"github.com/allanjose001/go-battleship/internal/ai"
 	"github.com/allanjose001/go-battleship/internal/entity"
 )
@@ -14,6 +11,9 @@ import (
 type DynamicBattleService interface {
 	BattleService
 	MovePlayerShip(ship *entity.Ship, newRow, newCol int) error
+	// EnemyMoveCount retorna quantas vezes a IA moveu um navio nesta sessão
+	// (a cena compara com o último valor visto para avisar o jogador).
+	EnemyMoveCount() int
 }
 
 type dynamicBattleService struct {
@@ -22,104 +22,39 @@ type dynamicBattleService struct {
 }
 
 // NewDynamicBattleServiceFromMatch inicializa o serviço de batalha dinâmica.
-func NewDynamicBattleServiceFromMatch(match *entity.Match, isCampaign bool, ss *audio.SoundService) (DynamicBattleService,
+func NewDynamicBattleServiceFromMatch(match *entity.Match, isCampaign bool, ss SoundPlayer, profiles *ProfileService) (DynamicBattleService,
 	error) {
+	if match == nil {
+		return nil, ErrMatchNotFound
+	}
+	if match.PlayerEntityBoard == nil || match.EnemyEntityBoard == nil {
+		return nil, ErrMatchNotReady
+	}
+	if profiles == nil {
+		profiles = NewProfileService(nil) // sem repositório: o perfil não é gravado
+	}
+
 	match.IsDynamicMode = true // Força a flag de modo dinâmico no objeto Match
 	// Usamos DynamicMatchService em vez do MatchService comum
-	dynamicMatchSvc := NewDynamicMatchService(NewAttackService(), 500*time.Millisecond, ss)
+	dynamicMatchSvc := NewDynamicMatchService(NewAttackService(), AIThinkDelay(ai.DynamicProfile.ID, nil), ss)
 
 	var aiPlayer *ai.AIPlayer
 
-	if match.PlayerEntityBoard == nil {
-		// Inicialização para novo jogo: precisamos converter PlayerShips (visual) para PlayerEntityBoard/Fleet (lógico)
-		playerFleet := entity.NewFleet()
-		playerEntityBoard := &entity.Board{}
-
-		// Mapeamento dos navios posicionados para a estrutura lógica
-		usedShips := make(map[int]bool)
-		for _, ps := range match.PlayerShips {
-			if ps == nil || !ps.Placed {
-				continue
-			}
-
-			var entShip *entity.Ship
-			for i, ship := range playerFleet.Ships {
-				if !usedShips[i] && ship.Size == ps.Size {
-					entShip = ship
-					usedShips[i] = true
-					break
-				}
-			}
-
-			if entShip != nil {
-				entShip.Horizontal = ps.Orientation == board.Horizontal
-				playerEntityBoard.PlaceShip(entShip, ps.Y, ps.X)
-			}
-		}
-
-		match.PlayerFleet = playerFleet
-		match.PlayerEntityBoard = playerEntityBoard
-
-		// Inicialização da IA
-		aiFleet := entity.NewFleet()
-		aiBoard := &entity.Board{}
-
-		// Mapeamento dos navios da IA (já posicionados visualmente) para a estrutura lógica
-		usedEnemyShips := make(map[int]bool)
-		for _, ps := range match.EnemyShips {
-			if ps == nil || !ps.Placed {
-				continue
-			}
-
-			var entShip *entity.Ship
-			for i, ship := range aiFleet.Ships {
-				if !usedEnemyShips[i] && ship.Size == ps.Size {
-					entShip = ship
-					usedEnemyShips[i] = true
-					break
-				}
-			}
-
-			if entShip != nil {
-				entShip.Horizontal = ps.Orientation == board.Horizontal
-				if !aiBoard.PlaceShip(entShip, ps.Y, ps.X) {
-					fmt.Printf("ERRO: Falha ao posicionar navio lógico IA (tamanho %d) em %d,%d\n", entShip.Size, ps.Y, ps.X)
-				}
-			} else {
-				fmt.Printf("ERRO: Não encontrou navio lógico IA para tamanho %d\n", ps.Size)
-			}
-		}
-
-		match.EnemyFleet = aiFleet
-		match.EnemyEntityBoard = aiBoard
+	if match.Status == entity.MatchStatusWaiting {
+		// Inicialização para novo jogo: boards/frotas lógicos já vêm do placement
+		playerEntityBoard, playerFleet := match.PlayerEntityBoard, match.PlayerFleet
+		aiBoard, aiFleet := match.EnemyEntityBoard, match.EnemyFleet
 
-		// Cria o DynamicAIPlayer APÓS atribuir EnemyEntityBoard ao match
-		aiPlayer = ai.NewDynamicAIPlayer(match.PlayerFleet, match.EnemyEntityBoard)
+		// O DynamicAIPlayer precisa do próprio board (EnemyEntityBoard) para evasão
+		aiPlayer = NewBattleSetupService().InitBattleAIWithBoard(ai.DynamicProfile.ID, match.PlayerFleet, match.BoardSize, match.EnemyEntityBoard, match.Rand())
+		ApplyPlacementHabits(aiPlayer, match.Profile, match.BoardSize)
 
-		// Adicione este log para confirmar
-		if match.EnemyEntityBoard == nil {
-			return nil, fmt.Errorf("EnemyEntityBoard nil após atribuição")
-		}
-
-		totalPlayerCells := 0
-		for _, ship := range playerFleet.GetFleetShips() {
-			if ship != nil {
-				totalPlayerCells += ship.Size
-			}
-		}
-
-		totalEnemyCells := 0
-		for _, ship := range aiFleet.GetFleetShips() {
-			if ship != nil {
-				totalEnemyCells += ship.Size
-			}
-		}
+		totalPlayerCells := playerFleet.TotalCells()
+		totalEnemyCells := aiFleet.TotalCells()
 
 		if err := dynamicMatchSvc.Start(
 			match,
 			time.Now(),
-			match.PlayerBoard,
-			match.EnemyBoard,
 			playerEntityBoard,
 			aiBoard,
 			playerFleet,
@@ -131,7 +66,8 @@ func NewDynamicBattleServiceFromMatch(match *entity.Match, isCampaign bool, ss *
 		}
 	} else {
 		// Caso já esteja inicializado (retomada de estado)
-		aiPlayer = ai.NewDynamicAIPlayer(match.PlayerFleet, match.EnemyEntityBoard)
+		aiPlayer = NewBattleSetupService().InitBattleAIWithBoard(ai.DynamicProfile.ID, match.PlayerFleet, match.BoardSize, match.EnemyEntityBoard, match.Rand())
+		ApplyPlacementHabits(aiPlayer, match.Profile, match.BoardSize)
 	}
 
 	baseSvc := &battleService{
@@ -139,8 +75,13 @@ func NewDynamicBattleServiceFromMatch(match *entity.Match, isCampaign bool, ss *
 		match:      match,
 		aiPlayer:   aiPlayer,
 		profile:    match.Profile,
+		profiles:   profiles,
 		isCampaign: isCampaign,
 	}
+	// a evasão da IA precisa saber quais navios dela o jogador acertou
+	baseSvc.onPlayerShots = func(events ...entity.AttackEvent) {
+		dynamicMatchSvc.RegisterPlayerHits(aiPlayer, events...)
+	}
 
 	return &dynamicBattleService{
 		battleService:   baseSvc,
@@ -158,5 +99,10 @@ func (s *dynamicBattleService) MovePlayerShip(ship *entity.Ship, newRow, newCol
 		return err
 	}
 
+	s.saveProgress()
 	return nil
 }
+
+func (s *dynamicBattleService) EnemyMoveCount() int {
+	return s.enemyMoves
+}
@@ -5,8 +5,7 @@ import (
 	"fmt"
 	"time"
 
-	"github.com/allanjose001/go-battleship/game/scenes/audio"
-	"github.com/allanjose001/go-battleship/game/shared/board"
+	"github.com/allanjose001/go-battleship/internal/ai"
 	"github.com/allanjose001/go-battleship/internal/entity"
 )
 
@@ -15,7 +14,7 @@ type DynamicMatchService struct {
 }
 
 // Corrigido: recebe attack e aiDelay (mesma semântica de NewMatchService)
-func NewDynamicMatchService(attack *AttackService, aiDelay time.Duration, ss *audio.SoundService) *DynamicMatchService {
+func NewDynamicMatchService(attack *AttackService, aiDelay time.Duration, ss SoundPlayer) *DynamicMatchService {
 	return &DynamicMatchService{
 		MatchService: NewMatchService(attack, aiDelay, ss),
 	}
@@ -23,8 +22,8 @@ func NewDynamicMatchService(attack *AttackService, aiDelay time.Duration, ss *au
 
 // MovePlayerShip tenta mover `ship` do jogador para (newRow,newCol).
 // now: tempo atual usado para agendamento do próximo passo da IA.
-// Observação: MoveShip foi implementado em internal/entity/Board (PlayerEntityBoard),
-// portanto chamamos ali. Se quiser refletir no PlayerBoard (visual), sincronize depois.
+// Observação: MoveShip foi implementado em internal/entity/Board (PlayerEntityBoard).
+// O renderer lê a posição do navio direto dali, então não há nada para sincronizar.
 func (s *DynamicMatchService) MovePlayerShip(m *entity.Match, ship *entity.Ship, newRow int, newCol int, now time.Time) error {
 	if m == nil {
 		return ErrMatchNotFound
@@ -39,7 +38,7 @@ func (s *DynamicMatchService) MovePlayerShip(m *entity.Match, ship *entity.Ship,
 		return ErrNotPlayersTurn
 	}
 	// precisa das referências runtime presentes
-	if m.PlayerEntityBoard == nil || m.PlayerBoard == nil {
+	if m.PlayerEntityBoard == nil {
 		return ErrMatchNotReady
 	}
 	if ship == nil {
@@ -64,9 +63,9 @@ func (s *DynamicMatchService) MovePlayerShip(m *entity.Match, ship *entity.Ship,
 	if err := m.PlayerEntityBoard.MoveShip(ship, newRow, newCol); err != nil {
 		return err
 	}
-
-	// sincroniza o board visual para refletir a nova posição dos navios
-	s.syncVisualShipPositions(m)
+	if m.Record != nil {
+		m.Record.AddMove(entity.TurnPlayer, entity.FleetShipIndex(m.PlayerFleet, ship), newRow, newCol, now)
+	}
 
 	// consumir o turno do jogador: passa para IA e agenda próximo ataque
 	m.Turn = entity.TurnEnemy
@@ -76,28 +75,16 @@ func (s *DynamicMatchService) MovePlayerShip(m *entity.Match, ship *entity.Ship,
 	return nil
 }
 
-// syncVisualShipPositions garante que o board visual (usado pelo AttackService e Renderer)
-// esteja em sincronia com o board lógico (onde os navios realmente se movem).
-func (s *DynamicMatchService) syncVisualShipPositions(m *entity.Match) {
-	if m.PlayerBoard == nil || m.PlayerEntityBoard == nil {
+// RegisterPlayerHits avisa aiPlayer dos acertos do jogador nos navios dela, para
+// que a evasão tire da linha de tiro os navios ameaçados. Deve ser chamado logo
+// depois do ataque, enquanto os navios atingidos ainda estão nas células.
+func (s *DynamicMatchService) RegisterPlayerHits(aiPlayer *ai.AIPlayer, events ...entity.AttackEvent) {
+	if aiPlayer == nil {
 		return
 	}
-
-	for r := 0; r < 10; r++ {
-		for c := 0; c < 10; c++ {
-			entPos := m.PlayerEntityBoard.Positions[r][c]
-			cell := &m.PlayerBoard.Cells[r][c]
-
-			// Se a célula já foi atacada (Hit/Miss no visual), não alteramos seu estado.
-			// O AttackService já cuida de manter Hit/Miss sincronizado.
-			// Aqui só nos importamos com células não atacadas que podem ter ganhado ou perdido um navio.
-			if cell.State != board.Hit && cell.State != board.Miss {
-				if entity.GetShipReference(entPos) != nil {
-					cell.State = board.Ship
-				} else {
-					cell.State = board.Empty
-				}
-			}
+	for _, ev := range events {
+		if ev.Attacker == entity.TurnPlayer && ev.Valid && ev.Hit {
+			aiPlayer.RegisterIncomingHit(ev.Row, ev.Col)
 		}
 	}
 }
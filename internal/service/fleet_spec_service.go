@@ -0,0 +1,112 @@
+package service
+
+import (
+	"fmt"
+	"io/fs"
+	"os"
+	"sync"
+
+	battleship "github.com/allanjose001/go-battleship"
+	"github.com/allanjose001/go-battleship/internal/entity"
+)
+
+// fleetSpecsDir guarda composições extras em JSON (uma por arquivo), embutidas
+// no binário (battleship.Assets).
+const fleetSpecsDir string = "assets/fleets"
+
+// customFleetSpecsDir é onde o jogador põe composições próprias, dentro do
+// diretório de dados (ver DataDir): entram no jogo sem recompilar.
+const customFleetSpecsDir string = "fleets"
+
+var (
+	fleetSpecs     []entity.FleetSpec
+	fleetSpecsOnce sync.Once
+)
+
+// AvailableFleetSpecs retorna as composições embutidas seguidas das carregadas
+// de fleetSpecsDir e de customFleetSpecsDir (ver loadJSONFleetSpecs).
+// Arquivos inválidos são ignorados para não impedir o jogo de continuar.
+func AvailableFleetSpecs() []entity.FleetSpec {
+	fleetSpecsOnce.Do(func() {
+		fleetSpecs = []entity.FleetSpec{entity.DefaultFleetSpec, entity.ClassicFleetSpec}
+
+		for _, spec := range loadJSONFleetSpecs() {
+			if _, exists := findFleetSpec(fleetSpecs, spec.ID); exists {
+				continue // embutidas têm prioridade
+			}
+			fleetSpecs = append(fleetSpecs, spec)
+		}
+	})
+	return fleetSpecs
+}
+
+// FindFleetSpec procura uma composição pelo id.
+func FindFleetSpec(id string) (entity.FleetSpec, bool) {
+	return findFleetSpec(AvailableFleetSpecs(), id)
+}
+
+func findFleetSpec(specs []entity.FleetSpec, id string) (entity.FleetSpec, bool) {
+	for _, spec := range specs {
+		if spec.ID == id {
+			return spec, true
+		}
+	}
+	return entity.FleetSpec{}, false
+}
+
+// loadJSONFleetSpecs carrega as composições em JSON embutidas (fleetSpecsDir) e
+// as do diretório de dados (customFleetSpecsDir); um arquivo do disco
+// substitui o embutido de mesmo id.
+func loadJSONFleetSpecs() []entity.FleetSpec {
+	specs, err := LoadFleetSpecsDir(battleship.Assets, fleetSpecsDir)
+	if err != nil {
+		fmt.Println("Erro carregando frotas:", err)
+	}
+
+	custom, err := LoadFleetSpecsDir(os.DirFS(DataDir()), customFleetSpecsDir)
+	if err != nil {
+		fmt.Printf("Erro carregando frotas de %s: %v\n", DataDir(), err)
+	}
+	return overrideByID(specs, custom, func(s entity.FleetSpec) string { return s.ID })
+}
+
+// LoadFleetSpecFile lê uma composição de frota de um arquivo JSON em fsys
+// (os.DirFS para ler do disco).
+func LoadFleetSpecFile(fsys fs.FS, name string) (entity.FleetSpec, error) {
+	data, err := fs.ReadFile(fsys, name)
+	if err != nil {
+		return entity.FleetSpec{}, err
+	}
+
+	spec, err := entity.ParseFleetSpec(data)
+	if err != nil {
+		return entity.FleetSpec{}, fmt.Errorf("%s: %w", name, err)
+	}
+	return spec, nil
+}
+
+// LoadFleetSpecsDir carrega as composições de frota de dir em fsys (ver loadJSONDir).
+func LoadFleetSpecsDir(fsys fs.FS, dir string) ([]entity.FleetSpec, error) {
+	return loadJSONDir(fsys, dir, LoadFleetSpecFile)
+}
+
+// ResolveFleetSpec garante uma composição utilizável no tabuleiro da partida,
+// caindo para a frota padrão se a escolhida não couber. A composição vazia
+// (nenhuma escolhida) é a padrão, sem aviso.
+func ResolveFleetSpec(spec entity.FleetSpec, boardSize int) entity.FleetSpec {
+	if spec.ShipCount() == 0 {
+		return entity.DefaultFleetSpec
+	}
+	if err := spec.Validate(boardSize); err != nil {
+		fmt.Printf("Frota %q inválida para %dx%d: %v (usando padrão)\n", spec.Name, boardSize, boardSize, err)
+		return entity.DefaultFleetSpec
+	}
+	return spec
+}
+
+// ResolveNoTouch diz se a regra "navios não se encostam" vale para a partida:
+// ela é desligada quando a frota não cabe no tabuleiro com a margem entre os navios.
+// Quem oferece a regra avisa o jogador (ver ModeSelectionScene).
+func ResolveNoTouch(noTouch bool, spec entity.FleetSpec, boardSize int) bool {
+	return noTouch && spec.FitsNoTouch(boardSize)
+}
@@ -0,0 +1,197 @@
+// MatchSaveService: salva e retoma a partida em andamento de um perfil.
+// O snapshot guarda o Match (turno, agenda, stats, sequências), os dois
+// boards com as frotas, o estado interno da IA e a posição do gerador
+// aleatório, para a partida continuar exatamente de onde parou.
+package service
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/allanjose001/go-battleship/internal/ai"
+	"github.com/allanjose001/go-battleship/internal/entity"
+	"github.com/allanjose001/go-battleship/internal/repository"
+)
+
+// MatchSaveVersion é a versão do formato de MatchSave.
+const MatchSaveVersion = 1
+
+// ErrNoSavedMatch indica que o perfil não tem partida em andamento salva.
+var ErrNoSavedMatch = errors.New("no saved match")
+
+// MatchSave é o snapshot de uma partida em andamento.
+type MatchSave struct {
+	Version   int                  `json:"version"`
+	Username  string               `json:"username"`
+	SavedAt   time.Time            `json:"saved_at"`
+	Match     *entity.Match        `json:"match"`
+	Player    entity.BoardSnapshot `json:"player_board"`
+	Enemy     entity.BoardSnapshot `json:"enemy_board"`
+	AI        ai.State             `json:"ai"`
+	RandDraws int64                `json:"rand_draws"`
+	Record    *entity.MatchRecord  `json:"record,omitempty"`
+}
+
+// SnapshotMatch copia o estado da partida e da IA no instante now.
+func SnapshotMatch(m *entity.Match, aiPlayer *ai.AIPlayer, username string, now time.Time) (*MatchSave, error) {
+	if m == nil {
+		return nil, ErrMatchNotFound
+	}
+	if m.PlayerEntityBoard == nil || m.EnemyEntityBoard == nil || aiPlayer == nil {
+		return nil, ErrMatchNotReady
+	}
+
+	save := &MatchSave{
+		Version:   MatchSaveVersion,
+		Username:  username,
+		SavedAt:   now,
+		Match:     m,
+		Player:    entity.SnapshotBoard(m.PlayerEntityBoard, m.PlayerFleet),
+		Enemy:     entity.SnapshotBoard(m.EnemyEntityBoard, m.EnemyFleet),
+		AI:        aiPlayer.State(m.EnemyFleet),
+		RandDraws: m.RandDraws(),
+		Record:    m.Record,
+	}
+	return save, nil
+}
+
+// SaveMatchInProgress grava o snapshot em savesDir/<username>.json. A gravação
+// é atômica: uma queda no meio deixa o save anterior inteiro, e não um arquivo
+// cortado que HasMatchInProgress ofereceria para continuar.
+func SaveMatchInProgress(save *MatchSave) error {
+	if save == nil || save.Username == "" {
+		return errors.New("save de partida sem perfil")
+	}
+
+	data, err := json.Marshal(save)
+	if err != nil {
+		return err
+	}
+	return repository.WriteFileAtomic(matchSavePath(save.Username), data, 0644)
+}
+
+// LoadMatchInProgress lê a partida salva do perfil.
+func LoadMatchInProgress(username string) (*MatchSave, error) {
+	data, err := os.ReadFile(matchSavePath(username))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, ErrNoSavedMatch
+		}
+		return nil, err
+	}
+
+	var save MatchSave
+	if err := json.Unmarshal(data, &save); err != nil {
+		return nil, err
+	}
+	if save.Version != MatchSaveVersion || save.Match == nil {
+		return nil, fmt.Errorf("save de partida incompatível (versão %d)", save.Version)
+	}
+	return &save, nil
+}
+
+// HasMatchInProgress indica se o perfil tem partida salva para continuar.
+func HasMatchInProgress(username string) bool {
+	_, err := os.Stat(matchSavePath(username))
+	return err == nil
+}
+
+// DeleteMatchInProgress apaga a partida salva do perfil (fim de jogo ou abandono).
+func DeleteMatchInProgress(username string) error {
+	err := os.Remove(matchSavePath(username))
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
+
+func matchSavePath(username string) string {
+	return filepath.Join(savesDir(), filepath.Base(username)+".json")
+}
+
+// Restore reconstrói o Match com boards, frotas e gerador aleatório.
+// Os horários são deslocados pelo tempo em que a partida ficou salva,
+// para a pausa não contar na duração nem na pontuação.
+func (s *MatchSave) Restore(profile *entity.Profile, now time.Time) (*entity.Match, error) {
+	m := s.Match
+
+	playerBoard, playerFleet, err := s.Player.Restore()
+	if err != nil {
+		return nil, fmt.Errorf("tabuleiro do jogador: %w", err)
+	}
+	enemyBoard, enemyFleet, err := s.Enemy.Restore()
+	if err != nil {
+		return nil, fmt.Errorf("tabuleiro inimigo: %w", err)
+	}
+
+	m.PlayerEntityBoard, m.PlayerFleet = playerBoard, playerFleet
+	m.EnemyEntityBoard, m.EnemyFleet = enemyBoard, enemyFleet
+	m.Profile = profile
+	m.Record = s.Record
+	m.RestoreRand(s.RandDraws)
+
+	pause := now.Sub(s.SavedAt)
+	if pause > 0 {
+		m.StartedAt = shiftTime(m.StartedAt, pause)
+		m.NextActionAt = shiftTime(m.NextActionAt, pause)
+		m.LastAttackAt = shiftTime(m.LastAttackAt, pause)
+		if m.Record != nil {
+			m.Record.StartedAt = shiftTime(m.Record.StartedAt, pause)
+		}
+	}
+	return m, nil
+}
+
+func shiftTime(t time.Time, d time.Duration) time.Time {
+	if t.IsZero() {
+		return t
+	}
+	return t.Add(d)
+}
+
+// ResumeBattleService carrega a partida salva do perfil e recria o serviço de
+// batalha dela (dinâmico ou clássico) com a IA no estado salvo.
+// Para o modo dinâmico o serviço devolvido também é um DynamicBattleService.
+func ResumeBattleService(profile *entity.Profile, ss SoundPlayer, profiles *ProfileService, now time.Time) (*entity.Match, BattleService, error) {
+	if profile == nil {
+		return nil, nil, ErrNoSavedMatch
+	}
+
+	save, err := LoadMatchInProgress(profile.Username)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	m, err := save.Restore(profile, now)
+	if err != nil {
+		return nil, nil, err
+	}
+	if m.Status != entity.MatchStatusInProgress {
+		return nil, nil, ErrMatchNotInProgress
+	}
+
+	var svc BattleService
+	var base *battleService
+	if m.IsDynamicMode {
+		dyn, err := NewDynamicBattleServiceFromMatch(m, false, ss, profiles)
+		if err != nil {
+			return nil, nil, err
+		}
+		svc, base = dyn, dyn.(*dynamicBattleService).battleService
+	} else {
+		bs, err := NewBattleServiceFromMatch(m, false, ss, profiles)
+		if err != nil {
+			return nil, nil, err
+		}
+		svc, base = bs, bs.(*battleService)
+	}
+
+	if err := base.aiPlayer.RestoreState(save.AI, m.EnemyFleet); err != nil {
+		return nil, nil, err
+	}
+	return m, svc, nil
+}
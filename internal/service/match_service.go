@@ -4,8 +4,6 @@ import (
 	"errors"
 	"time"
 
-	"github.com/allanjose001/go-battleship/game/scenes/audio"
-	"github.com/allanjose001/go-battleship/game/shared/board"
 	"github.com/allanjose001/go-battleship/internal/ai"
 	"github.com/allanjose001/go-battleship/internal/entity"
 )
@@ -36,17 +34,24 @@ var (
 	ErrMatchNotReady = errors.New("match runtime references not set")
 )
 
+// SoundPlayer é o que o domínio precisa de um sistema de áudio: tocar efeitos.
+// A camada game injeta o SoundService do Ebiten; em modo headless pode ser nil.
+type SoundPlayer interface {
+	PlaySFX(name string, vol float64)
+}
+
 type MatchService struct {
 	attack  *AttackService
 	aiDelay time.Duration
-	ss      *audio.SoundService
+	ss      SoundPlayer
 }
 
 // NewMatchService cria um MatchService.
 //
 // attack: pode ser nil; se nil, usa NewAttackService().
 // aiDelay: delay mínimo entre ataques da IA; se <= 0, usa 1s.
-func NewMatchService(attack *AttackService, aiDelay time.Duration, ss *audio.SoundService) *MatchService {
+// ss: pode ser nil (sem som, ex.: simulação ou testes).
+func NewMatchService(attack *AttackService, aiDelay time.Duration, ss SoundPlayer) *MatchService {
 	if aiDelay <= 0 {
 		aiDelay = time.Second
 	}
@@ -62,9 +67,9 @@ func NewMatchService(attack *AttackService, aiDelay time.Duration, ss *audio.Sou
 
 // Create cria um Match em memória.
 // Como o Match não é persistido, este método apenas devolve um novo Match.
-func (s *MatchService) Create(id string, difficulty string) *entity.Match {
+func (s *MatchService) Create(id string, difficulty string, boardSize int) *entity.Match {
 	// Passamos a dificuldade para o construtor da entidade
-	return entity.NewMatch(id, difficulty, nil, nil, nil, nil, nil, false)
+	return entity.NewMatch(id, difficulty, boardSize, nil, false)
 }
 
 // Start inicializa o Match e injeta referências runtime necessárias para jogar.
@@ -77,8 +82,6 @@ func (s *MatchService) Create(id string, difficulty string) *entity.Match {
 func (s *MatchService) Start(
 	m *entity.Match,
 	now time.Time,
-	playerBoard *board.Board,
-	enemyBoard *board.Board,
 	playerEntityBoard *entity.Board,
 	enemyEntityBoard *entity.Board,
 	playerFleet *entity.Fleet,
@@ -94,8 +97,6 @@ func (s *MatchService) Start(
 	}
 
 	// refs runtime (não persistem)
-	m.PlayerBoard = playerBoard
-	m.EnemyBoard = enemyBoard
 	m.PlayerEntityBoard = playerEntityBoard
 	m.EnemyEntityBoard = enemyEntityBoard
 	m.PlayerFleet = playerFleet
@@ -107,6 +108,9 @@ func (s *MatchService) Start(
 
 	// reseta status/turn/stats/agenda
 	m.Start(now)
+
+	// log para replay: seed e posicionamento inicial das duas frotas
+	m.Record = entity.NewMatchRecord(m)
 	return nil
 }
 
@@ -133,6 +137,12 @@ func (s *MatchService) PlayerAttack(m *entity.Match, now time.Time, row, col int
 
 	hit, gameOver := s.applyPlayerAttack(m, row, col)
 	ev := s.makeEvent(entity.TurnPlayer, row, col, true, hit)
+	if hit {
+		s.fillSunk(&ev, m.EnemyEntityBoard, row, col)
+	}
+	if m.Record != nil {
+		m.Record.AddAttack(ev, now)
+	}
 
 	if err := s.postPlayerAttack(m, now, hit, gameOver, &ev); err != nil {
 		return ev, err
@@ -150,9 +160,25 @@ func (s *MatchService) EnemyAttackStep(m *entity.Match, now time.Time, aiPlayer
 		return entity.AttackEvent{}, err
 	}
 
+	prevShots := m.PlayerEntityBoard.ShotCount()
+	prevPositions := shipPositions(m.EnemyFleet)
+
 	hit, gameOver := s.applyEnemyStep(m, aiPlayer)
 	ev := s.makeEvent(entity.TurnEnemy, -1, -1, true, hit)
 
+	// a IA pode mover navios no próprio turno (modo dinâmico)
+	ev.Moved = s.recordEnemyMoves(m, prevPositions, now)
+
+	if m.PlayerEntityBoard.ShotCount() > prevShots {
+		ev.Row, ev.Col = m.PlayerEntityBoard.LastShot()
+		if hit {
+			s.fillSunk(&ev, m.PlayerEntityBoard, ev.Row, ev.Col)
+		}
+		if m.Record != nil {
+			m.Record.AddAttack(ev, now)
+		}
+	}
+
 	if err := s.postEnemyStep(m, now, hit, gameOver, &ev); err != nil {
 		return ev, err
 	}
@@ -176,16 +202,12 @@ func (s *MatchService) validatePlayerAttack(m *entity.Match, row, col int) error
 	if m.Turn != entity.TurnPlayer {
 		return ErrNotPlayersTurn
 	}
-	if m.EnemyBoard == nil {
+	if m.EnemyEntityBoard == nil {
 		return ErrMatchNotReady
 	}
 
-	if row < 0 || row >= board.Rows || col < 0 || col >= board.Cols {
-		return entity.ErrInvalidAttackCell
-	}
-
-	cell := &m.EnemyBoard.Cells[row][col]
-	if cell.State == board.Hit || cell.State == board.Miss {
+	// fora do tabuleiro ou já atacada
+	if !m.EnemyEntityBoard.CheckPosition(row, col) {
 		return entity.ErrInvalidAttackCell
 	}
 
@@ -194,7 +216,7 @@ func (s *MatchService) validatePlayerAttack(m *entity.Match, row, col int) error
 
 func (s *MatchService) applyPlayerAttack(m *entity.Match, row, col int) (hit bool, gameOver bool) {
 	m.PlayerShots, m.PlayerHits, hit, gameOver =
-		s.attack.PlayerAttack(m.EnemyBoard, m.EnemyEntityBoard, row, col, m.PlayerShots, m.PlayerHits, m.TotalEnemyShipCells)
+		s.attack.PlayerAttack(m.EnemyEntityBoard, row, col, m.PlayerShots, m.PlayerHits, m.TotalEnemyShipCells)
 
 	if hit {
 		m.PlayerHitStreak++
@@ -209,7 +231,7 @@ func (s *MatchService) applyPlayerAttack(m *entity.Match, row, col int) (hit boo
 
 func (s *MatchService) postPlayerAttack(m *entity.Match, now time.Time, hit, gameOver bool, ev *entity.AttackEvent) error {
 	if hit {
-		s.ss.PlaySFX("attack", 0.6)
+		s.playSFX("attack", 0.6)
 		// atualiza score de forma limpa
 		m.UpdateScore(true, now)
 	}
@@ -220,7 +242,7 @@ func (s *MatchService) postPlayerAttack(m *entity.Match, now time.Time, hit, gam
 	}
 
 	if !hit {
-		s.ss.PlaySFX("watersplash", 1)
+		s.playSFX("watersplash", 1)
 		// passa turno para a IA
 		m.Turn = entity.TurnEnemy
 		m.NextAction = entity.NextActionEnemyAttack
@@ -248,7 +270,7 @@ func (s *MatchService) validateEnemyStep(m *entity.Match, now time.Time, aiPlaye
 	if now.Before(m.NextActionAt) {
 		return ErrActionNotReady
 	}
-	if aiPlayer == nil || m.PlayerEntityBoard == nil || m.PlayerBoard == nil {
+	if aiPlayer == nil || m.PlayerEntityBoard == nil {
 		return ErrMatchNotReady
 	}
 	return nil
@@ -265,7 +287,6 @@ func (s *MatchService) applyEnemyStep(m *entity.Match, aiPlayer *ai.AIPlayer) (h
 		s.attack.AITurn(
 			aiPlayer,
 			m.PlayerEntityBoard,
-			m.PlayerBoard,
 			m.EnemyShots,
 			m.EnemyHits,
 			m.TotalPlayerShipCells,
@@ -274,13 +295,13 @@ func (s *MatchService) applyEnemyStep(m *entity.Match, aiPlayer *ai.AIPlayer) (h
 	hit = m.EnemyHits > prevHits
 
 	if hit {
-		s.ss.PlaySFX("attack", 0.6)
+		s.playSFX("attack", 0.6)
 		m.EnemyHitStreak++
 		if m.EnemyHitStreak > m.EnemyMaxHitStreak {
 			m.EnemyMaxHitStreak = m.EnemyHitStreak
 		}
 	} else {
-		s.ss.PlaySFX("watersplash", 1)
+		s.playSFX("watersplash", 1)
 
 		m.EnemyHitStreak = 0
 	}
@@ -317,12 +338,67 @@ func (s *MatchService) makeEvent(attacker entity.TurnOwner, row, col int, valid,
 	}
 }
 
+// fillSunk marca o evento como afundamento se o navio atingido em (row, col) foi destruído.
+func (s *MatchService) fillSunk(ev *entity.AttackEvent, b *entity.Board, row, col int) {
+	if b == nil {
+		return
+	}
+	ship := b.ShipAt(row, col)
+	if ship == nil || !ship.IsDestroyed() {
+		return
+	}
+	ev.Sunk = true
+	ev.ShipName = ship.Name
+	ev.ShipSize = ship.Size
+	ev.ShipCells = ship.Cells()
+}
+
+// shipPositions guarda o top-left de cada navio da frota, na ordem da frota.
+func shipPositions(f *entity.Fleet) []entity.Cell {
+	if f == nil {
+		return nil
+	}
+	cells := make([]entity.Cell, len(f.Ships))
+	for i, ship := range f.Ships {
+		cells[i] = entity.Cell{Row: ship.Row, Col: ship.Col}
+	}
+	return cells
+}
+
+// recordEnemyMoves registra no log os navios da IA que mudaram de posição
+// e informa se algum mudou.
+func (s *MatchService) recordEnemyMoves(m *entity.Match, before []entity.Cell, now time.Time) bool {
+	if m.EnemyFleet == nil {
+		return false
+	}
+	moved := false
+	for i, ship := range m.EnemyFleet.Ships {
+		if i < len(before) && (ship.Row != before[i].Row || ship.Col != before[i].Col) {
+			moved = true
+			if m.Record != nil {
+				m.Record.AddMove(entity.TurnEnemy, i, ship.Row, ship.Col, now)
+			}
+		}
+	}
+	return moved
+}
+
 func (s *MatchService) finishAndFillWinner(m *entity.Match, now time.Time, winner entity.TurnOwner, ev *entity.AttackEvent) {
 	m.Finish(now, winner)
+	if m.Record != nil {
+		m.Record.Winner = winner
+	}
 	ev.GameOver = true
 	ev.Winner = winner
 }
 
+// playSFX toca um efeito sonoro se houver um SoundPlayer injetado.
+func (s *MatchService) playSFX(name string, vol float64) {
+	if s.ss != nil {
+		s.ss.PlaySFX(name, vol)
+	}
+}
+
 // ResultForPlayer converte o estado final do Match em MatchResult do ponto de vista do player.
 func (s *MatchService) ResultForPlayer(m *entity.Match) entity.MatchResult {
 	return m.Result()
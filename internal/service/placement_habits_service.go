@@ -0,0 +1,65 @@
+package service
+
+import (
+	"github.com/allanjose001/go-battleship/internal/ai"
+	"github.com/allanjose001/go-battleship/internal/entity"
+)
+
+// RecordPlacementHabits soma no perfil o posicionamento inicial do jogador na
+// partida m (pelo log da partida; sem log, pela posição atual da frota).
+// Só altera o perfil em memória: quem chama decide quando salvar.
+func RecordPlacementHabits(profile *entity.Profile, m *entity.Match) {
+	if profile == nil || m == nil {
+		return
+	}
+
+	var ships []entity.ShipRecord
+	if m.Record != nil {
+		ships = m.Record.Player
+	} else if m.PlayerFleet != nil {
+		for _, ship := range m.PlayerFleet.Ships {
+			if ship != nil {
+				ships = append(ships, entity.ShipRecord{Size: ship.Size, Row: ship.Row, Col: ship.Col, Horizontal: ship.Horizontal})
+			}
+		}
+	}
+	if len(ships) == 0 {
+		return
+	}
+
+	if profile.PlacementHabits == nil {
+		profile.PlacementHabits = &entity.PlacementHabits{}
+	}
+	profile.PlacementHabits.Record(m.BoardSize, ships)
+}
+
+// LearnPlacementHabits registra o posicionamento da partida m no perfil salvo de
+// username e o grava. Usado onde o resultado não passa por AddMatch (campanha).
+func (s *ProfileService) LearnPlacementHabits(username string, m *entity.Match) error {
+	profile, err := s.Find(username)
+	if err != nil {
+		return err
+	}
+	RecordPlacementHabits(profile, m)
+	return s.Save(*profile)
+}
+
+// ApplyPlacementHabits entrega à IA os hábitos de posicionamento do jogador do
+// perfil no tabuleiro boardSize (nada acontece sem hábitos registrados).
+func ApplyPlacementHabits(aiPlayer *ai.AIPlayer, profile *entity.Profile, boardSize int) {
+	if aiPlayer == nil || profile == nil {
+		return
+	}
+	if prior := profile.PlacementHabits.Frequency(boardSize); prior != nil {
+		aiPlayer.SetPlacementPrior(prior)
+	}
+}
+
+// ResetPlacementHabits apaga o que a IA aprendeu sobre o posicionamento do jogador.
+func (s *ProfileService) ResetPlacementHabits(profile *entity.Profile) error {
+	if profile == nil {
+		return nil
+	}
+	profile.PlacementHabits = nil
+	return s.Save(*profile)
+}
@@ -0,0 +1,111 @@
+package service
+
+import (
+	"fmt"
+
+	"github.com/allanjose001/go-battleship/internal/ai"
+	"github.com/allanjose001/go-battleship/internal/entity"
+)
+
+const (
+	// heatmapFirstShots é quantos tiros do começo de cada partida entram no mapa.
+	heatmapFirstShots = 15
+	// heatmapMaxMatches limita quantas partidas recentes do perfil são lidas.
+	heatmapMaxMatches = 20
+)
+
+// NewAIPlacement monta o posicionamento da frota da IA para a dificuldade
+// (perfil de IA) contra opponent. O mapa de calor só é calculado para a política
+// ai.PlacementAntiHeatmap; opponent nil usa o mapa genérico.
+func NewAIPlacement(difficulty string, opponent *entity.Profile, boardSize int) AIPlacement {
+	p := AIPlacement{Policy: ResolvePlayerAIProfile(difficulty, opponent).Placement}
+	if p.Policy == ai.PlacementAntiHeatmap {
+		p.Heat = FirstShotHeatmap(opponent, boardSize)
+	}
+	return p
+}
+
+// FirstShotHeatmap conta onde o jogador do perfil atirou no começo das últimas
+// partidas (pelos replays salvos), com peso maior para os primeiros tiros.
+// Tabuleiros de outra dimensão são reescalados. Sem histórico, devolve o mapa
+// genérico (defaultHeatmap).
+func FirstShotHeatmap(profile *entity.Profile, boardSize int) [][]float64 {
+	heat := newHeatmap(boardSize)
+	if profile == nil {
+		return defaultHeatmap(boardSize)
+	}
+
+	counted := 0
+	history := profile.History
+	for i := len(history) - 1; i >= 0 && counted < heatmapMaxMatches; i-- {
+		if history[i].ReplayID == "" {
+			continue
+		}
+		rec, err := LoadMatchRecord(history[i].ReplayID)
+		if err != nil {
+			fmt.Println("Erro lendo replay para o mapa de calor:", err)
+			continue
+		}
+		if addFirstShots(heat, rec) {
+			counted++
+		}
+	}
+
+	if counted == 0 {
+		return defaultHeatmap(boardSize)
+	}
+	return heat
+}
+
+// addFirstShots soma no mapa os primeiros tiros do jogador em rec.
+// Informa se a partida tinha algum tiro do jogador.
+func addFirstShots(heat [][]float64, rec *entity.MatchRecord) bool {
+	if rec.BoardSize <= 0 {
+		return false
+	}
+	size := len(heat)
+
+	shots := 0
+	for _, ev := range rec.Events {
+		if ev.Kind != entity.RecordAttack || ev.By != entity.TurnPlayer {
+			continue
+		}
+		row, col := ev.Row*size/rec.BoardSize, ev.Col*size/rec.BoardSize
+		heat[row][col] += float64(heatmapFirstShots - shots)
+
+		shots++
+		if shots == heatmapFirstShots {
+			break
+		}
+	}
+	return shots > 0
+}
+
+// defaultHeatmap é o mapa usado sem histórico: jogadores costumam abrir a partida
+// pelo centro do tabuleiro, então o calor cai com a distância ao centro.
+func defaultHeatmap(boardSize int) [][]float64 {
+	heat := newHeatmap(boardSize)
+	center := float64(boardSize-1) / 2
+	for i := range heat {
+		for j := range heat[i] {
+			d := max(absFloat(float64(i)-center), absFloat(float64(j)-center))
+			heat[i][j] = center + 1 - d
+		}
+	}
+	return heat
+}
+
+func newHeatmap(boardSize int) [][]float64 {
+	heat := make([][]float64, boardSize)
+	for i := range heat {
+		heat[i] = make([]float64, boardSize)
+	}
+	return heat
+}
+
+func absFloat(x float64) float64 {
+	if x < 0 {
+		return -x
+	}
+	return x
+}
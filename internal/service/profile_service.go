@@ -1,136 +1,95 @@
 package service
 
 import (
-	"encoding/json"
 	"fmt"
-	"os"
-	"path/filepath"
 
 	"github.com/allanjose001/go-battleship/internal/entity"
 	"github.com/allanjose001/go-battleship/internal/medal"
+	"github.com/allanjose001/go-battleship/internal/repository"
 )
 
-const defaultPath string = "internal/data/profiles.json"
-
-var profiles []entity.Profile
-
 type Profile = entity.Profile
 
-// init carrega profiles em memoria ao iniciar jogo
-func init() {
-	var err error
-	err = loadProfiles() //caso não carregue arquivos o jogo pode continuar normalmente
-	if err != nil {
-		fmt.Println("Erro carregando profiles:", err) // remover apos integração
-		profiles = []entity.Profile{}
-	}
+// ProfileService concentra as regras sobre perfis (medalhas, histórico,
+// ranking) e delega a persistência a um repository.ProfileRepository
+// injetado, para que testes e ferramentas não toquem no save real.
+type ProfileService struct {
+	repo   repository.ProfileRepository
+	notice string
 }
 
-// GetProfiles retorna lista de profiles
-func GetProfiles() []entity.Profile {
-	return profiles
+// NewProfileService cria o serviço sobre repo; nil usa um repositório em memória.
+func NewProfileService(repo repository.ProfileRepository) *ProfileService {
+	if repo == nil {
+		repo = repository.NewMemoryProfileRepository()
+	}
+	return &ProfileService{repo: repo}
 }
 
-// loadProfiles carrega profiles do arquivo para a variável em memória
-func loadProfiles() error {
-	data, err := os.ReadFile(defaultPath)
+// NewDefaultProfileService abre o arquivo de perfis do jogo (ProfilesPath).
+// Se ele estiver corrompido os perfis são recuperados do backup mais recente;
+// se nem isso for possível o jogo continua com perfis só em memória, sem
+// sobrescrever o arquivo. Nos dois casos o jogador é avisado (ver TakeNotice).
+func NewDefaultProfileService() *ProfileService {
+	repo, err := repository.NewJSONProfileRepository(ProfilesPath())
 	if err != nil {
-		if os.IsNotExist(err) {
-			profiles = []entity.Profile{}
-			return nil
-		}
-		return err
+		fmt.Println("Erro carregando profiles:", err)
+		s := NewProfileService(nil)
+		s.notice = "Não foi possível ler os perfis salvos: as alterações desta sessão não serão gravadas."
+		return s
 	}
 
-	err = json.Unmarshal(data, &profiles)
-	if err != nil {
-		return err
+	s := NewProfileService(repo)
+	if rec := repo.Recovery(); rec != nil {
+		fmt.Printf("Perfis recuperados de %s (arquivo corrompido: %v)\n", rec.Backup, rec.Cause)
+		s.notice = "O arquivo de perfis estava corrompido: os perfis foram restaurados do último backup."
 	}
-	return nil
+	return s
 }
 
-// SaveProfile é basicamente um alias para update, pois update acaba salvando o profile mesmo assim caso não exista
-func SaveProfile(profile entity.Profile) error {
-	return UpdateProfile(profile)
+// TakeNotice devolve o aviso pendente sobre o carregamento dos perfis (vazio
+// se não houver) e o descarta, para que ele seja mostrado uma vez só.
+func (s *ProfileService) TakeNotice() string {
+	notice := s.notice
+	s.notice = ""
+	return notice
 }
 
-func FindProfile(username string) (*Profile, error) {
-	for i, p := range profiles {
-		if p.Username == username {
-			return &profiles[i], nil
-		}
-	}
-	return nil, fmt.Errorf("perfil não encontrado: %s", username)
-}
-
-// UpdateProfile atualiza perfil em memoria e no arquivo json
-func UpdateProfile(profile entity.Profile) error {
-	updated := false
-
-	for i, p := range profiles {
-		if p.Username == profile.Username {
-			profiles[i] = profile
-			updated = true
-			break
-		}
-	}
-
-	if !updated {
-		profiles = append(profiles, profile)
-	}
-
-	dir := filepath.Dir(defaultPath)
-	if err := os.MkdirAll(dir, 0755); err != nil {
-		return err
-	}
-
-	data, err := json.MarshalIndent(profiles, "", "  ")
+// Profiles retorna lista de profiles
+func (s *ProfileService) Profiles() []entity.Profile {
+	profiles, err := s.repo.List()
 	if err != nil {
-		return err
+		fmt.Println("Erro listando profiles:", err)
+		return []entity.Profile{}
 	}
-
-	return os.WriteFile(defaultPath, data, 0644)
+	return profiles
 }
 
-// RemoveProfile apaga perfil em memoria e reescreve json com lista resultante
-func RemoveProfile(username string) error {
-	// procura e remove o profile da lista em memória
-	removed := false
-	newProfiles := make([]entity.Profile, 0, len(profiles))
-	for _, p := range profiles {
-		if p.Username != username {
-			newProfiles = append(newProfiles, p)
-		} else {
-			removed = true
-		}
-	}
-
-	if !removed {
-		return nil // não encontrou profile, nada a fazer
-	}
-
-	// atualiza a lista em memória
-	profiles = newProfiles
+// Find retorna uma cópia do perfil de username; alterações só valem depois de Save.
+func (s *ProfileService) Find(username string) (*Profile, error) {
+	return s.repo.Find(username)
+}
 
-	// grava no arquivo
-	data, err := json.MarshalIndent(profiles, "", "  ")
-	if err != nil {
-		return err
-	}
+// Save cria o perfil ou atualiza o de mesmo Username.
+func (s *ProfileService) Save(profile entity.Profile) error {
+	return s.repo.Save(profile)
+}
 
-	return os.WriteFile(defaultPath, data, 0644)
+// Remove apaga o perfil de username.
+func (s *ProfileService) Remove(username string) error {
+	return s.repo.Delete(username)
 }
 
-// AddMatchToProfile repassa salvamento para profile e atualiza saves no arquivo,
+// AddMatch registra o resultado no histórico e nas estatísticas do perfil e o salva,
 // retorna numero de medalhas ganhas apos partida
-func AddMatchToProfile(profile *entity.Profile, result entity.MatchResult) (int, error) {
+func (s *ProfileService) AddMatch(profile *entity.Profile, result entity.MatchResult) (int, error) {
 	profile.History = append(profile.History, result)
 
 	profile.Stats.ApplyMatch(result)
 
 	newMedals := checkNewMedals(profile)
 
-	e := UpdateProfile(*profile)
+	e := s.Save(*profile)
 
 	if e != nil {
 		return 0, e
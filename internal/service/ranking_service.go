@@ -5,11 +5,9 @@ import (
 	"sort"
 )
 
-func GetTopScores(limit int) []entity.Profile {
-	profiles := GetProfiles()
-
-	ranking := make([]entity.Profile, len(profiles))
-	copy(ranking, profiles)
+// TopScores devolve os limit perfis de maior pontuação total.
+func (s *ProfileService) TopScores(limit int) []entity.Profile {
+	ranking := s.Profiles()
 
 	sort.Slice(ranking, func(i, j int) bool {
 		return ranking[i].Stats.TotalScore > ranking[j].Stats.TotalScore
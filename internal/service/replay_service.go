@@ -0,0 +1,160 @@
+// ReplayService: guarda e carrega os logs de partida (entity.MatchRecord)
+// e reconstrói o estado dos tabuleiros passo a passo para o replay.
+// Os logs ficam um por arquivo, fora do profiles.json, e o MatchResult
+// só guarda o ReplayID.
+package service
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/allanjose001/go-battleship/internal/entity"
+)
+
+// ErrReplayNotFound indica que não existe log salvo para o ReplayID.
+var ErrReplayNotFound = errors.New("replay not found")
+
+// SaveMatchRecord grava o log em replaysDir/<ID>.json.
+func SaveMatchRecord(rec *entity.MatchRecord) error {
+	if rec == nil || rec.ID == "" {
+		return errors.New("match record sem ID")
+	}
+	if err := os.MkdirAll(replaysDir(), 0755); err != nil {
+		return err
+	}
+
+	data, err := json.Marshal(rec)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(replayPath(rec.ID), data, 0644)
+}
+
+// LoadMatchRecord lê o log salvo com o ReplayID informado.
+func LoadMatchRecord(id string) (*entity.MatchRecord, error) {
+	data, err := os.ReadFile(replayPath(id))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, fmt.Errorf("%w: %s", ErrReplayNotFound, id)
+		}
+		return nil, err
+	}
+
+	var rec entity.MatchRecord
+	if err := json.Unmarshal(data, &rec); err != nil {
+		return nil, err
+	}
+	return &rec, nil
+}
+
+func replayPath(id string) string {
+	return filepath.Join(replaysDir(), filepath.Base(id)+".json")
+}
+
+// Replayer aplica os eventos de um MatchRecord sobre boards/frotas reconstruídos,
+// usando as mesmas regras de entity.Board da partida original.
+type Replayer struct {
+	rec *entity.MatchRecord
+	pos int // quantidade de eventos já aplicados
+
+	PlayerBoard *entity.Board
+	PlayerFleet *entity.Fleet
+	EnemyBoard  *entity.Board
+	EnemyFleet  *entity.Fleet
+}
+
+// NewReplayer prepara o replay no estado inicial (nenhum evento aplicado).
+func NewReplayer(rec *entity.MatchRecord) (*Replayer, error) {
+	if rec == nil {
+		return nil, ErrReplayNotFound
+	}
+	r := &Replayer{rec: rec}
+	if err := r.reset(); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+func (r *Replayer) reset() error {
+	pb, pf, eb, ef, err := r.rec.BuildBoards()
+	if err != nil {
+		return err
+	}
+	r.PlayerBoard, r.PlayerFleet, r.EnemyBoard, r.EnemyFleet = pb, pf, eb, ef
+	r.pos = 0
+	return nil
+}
+
+// Record devolve o log sendo reproduzido.
+func (r *Replayer) Record() *entity.MatchRecord {
+	return r.rec
+}
+
+// Pos devolve quantos eventos já foram aplicados.
+func (r *Replayer) Pos() int {
+	return r.pos
+}
+
+// Len devolve o total de eventos do log.
+func (r *Replayer) Len() int {
+	return len(r.rec.Events)
+}
+
+// Done indica se todos os eventos já foram aplicados.
+func (r *Replayer) Done() bool {
+	return r.pos >= len(r.rec.Events)
+}
+
+// Last devolve o último evento aplicado.
+func (r *Replayer) Last() (entity.RecordEvent, bool) {
+	if r.pos == 0 {
+		return entity.RecordEvent{}, false
+	}
+	return r.rec.Events[r.pos-1], true
+}
+
+// Step aplica o próximo evento. Retorna false quando o log terminou.
+func (r *Replayer) Step() bool {
+	if r.Done() {
+		return false
+	}
+
+	ev := r.rec.Events[r.pos]
+	r.pos++
+
+	switch ev.Kind {
+	case entity.RecordAttack:
+		// tiro do jogador cai no board inimigo e vice-versa
+		target := r.EnemyBoard
+		if ev.By == entity.TurnEnemy {
+			target = r.PlayerBoard
+		}
+		target.AttackPositionA(ev.Row, ev.Col)
+	case entity.RecordMove:
+		b, f := r.PlayerBoard, r.PlayerFleet
+		if ev.By == entity.TurnEnemy {
+			b, f = r.EnemyBoard, r.EnemyFleet
+		}
+		if ev.Ship >= 0 && ev.Ship < len(f.Ships) {
+			if err := b.MoveShip(f.Ships[ev.Ship], ev.Row, ev.Col); err != nil {
+				fmt.Printf("replay: movimento inválido no evento %d: %v\n", r.pos-1, err)
+			}
+		}
+	}
+	return true
+}
+
+// Seek reconstrói o estado com exatamente n eventos aplicados.
+func (r *Replayer) Seek(n int) error {
+	if n < r.pos {
+		if err := r.reset(); err != nil {
+			return err
+		}
+	}
+	for r.pos < n && r.Step() {
+	}
+	return nil
+}
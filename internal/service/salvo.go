@@ -0,0 +1,168 @@
+package service
+
+import (
+	"errors"
+	"time"
+
+	"github.com/allanjose001/go-battleship/internal/ai"
+	"github.com/allanjose001/go-battleship/internal/entity"
+)
+
+// ErrInvalidSalvo indica salva com quantidade de tiros diferente da permitida
+// ou com alvos repetidos.
+var ErrInvalidSalvo = errors.New("invalid salvo")
+
+// Regras do modo Salvo:
+// - Cada lado dispara, por turno, um tiro por navio ainda vivo da própria frota.
+// - Os tiros da salva são aplicados juntos e os resultados revelados juntos.
+// - Acertar não dá turno extra: depois da salva o turno sempre passa.
+
+// SalvoShots retorna quantos tiros owner dispara na salva atual: um por navio vivo,
+// limitado às células ainda não atacadas do tabuleiro alvo.
+func (s *MatchService) SalvoShots(m *entity.Match, owner entity.TurnOwner) int {
+	fleet, target := m.PlayerFleet, m.EnemyEntityBoard
+	if owner == entity.TurnEnemy {
+		fleet, target = m.EnemyFleet, m.PlayerEntityBoard
+	}
+	if fleet == nil || target == nil {
+		return 0
+	}
+
+	shots := len(fleet.Ships) - fleet.DestroyedCount()
+	if free := target.Size*target.Size - target.ShotCount(); free < shots {
+		shots = free
+	}
+	return shots
+}
+
+// TogglePendingShot marca/desmarca (row, col) como alvo da próxima salva do jogador.
+// Retorna true quando a salva ficou completa e deve ser disparada.
+func (s *MatchService) TogglePendingShot(m *entity.Match, row, col int) (bool, error) {
+	if err := s.validatePlayerAttack(m, row, col); err != nil {
+		return false, err
+	}
+
+	cell := entity.Cell{Row: row, Col: col}
+	for i, c := range m.PendingShots {
+		if c == cell {
+			m.PendingShots = append(m.PendingShots[:i], m.PendingShots[i+1:]...)
+			return false, nil
+		}
+	}
+
+	m.PendingShots = append(m.PendingShots, cell)
+	return len(m.PendingShots) >= s.SalvoShots(m, entity.TurnPlayer), nil
+}
+
+// PlayerSalvo dispara a salva do jogador com exatamente SalvoShots alvos distintos.
+// Devolve um evento por tiro; o turno passa para a IA (ou a partida termina).
+func (s *MatchService) PlayerSalvo(m *entity.Match, now time.Time, cells []entity.Cell) ([]entity.AttackEvent, error) {
+	if len(cells) != s.SalvoShots(m, entity.TurnPlayer) {
+		return nil, ErrInvalidSalvo
+	}
+	seen := make(map[entity.Cell]bool, len(cells))
+	for _, c := range cells {
+		if err := s.validatePlayerAttack(m, c.Row, c.Col); err != nil {
+			return nil, err
+		}
+		if seen[c] {
+			return nil, ErrInvalidSalvo
+		}
+		seen[c] = true
+	}
+
+	m.PendingShots = nil
+
+	// todos os acertos da salva pontuam com o mesmo intervalo (desde o último
+	// acerto antes da salva): são um único disparo, não tiros em sequência
+	lastAttackAt := m.LastAttackAt
+
+	events := make([]entity.AttackEvent, 0, len(cells))
+	anyHit, gameOver := false, false
+	for _, c := range cells {
+		var hit bool
+		hit, gameOver = s.applyPlayerAttack(m, c.Row, c.Col)
+
+		ev := s.makeEvent(entity.TurnPlayer, c.Row, c.Col, true, hit)
+		if hit {
+			anyHit = true
+			m.LastAttackAt = lastAttackAt
+			m.UpdateScore(true, now)
+			s.fillSunk(&ev, m.EnemyEntityBoard, c.Row, c.Col)
+		}
+		if m.Record != nil {
+			m.Record.AddAttack(ev, now)
+		}
+		events = append(events, ev)
+
+		if gameOver {
+			s.finishAndFillWinner(m, now, entity.TurnPlayer, &events[len(events)-1])
+			break
+		}
+	}
+
+	s.playSalvoSFX(anyHit)
+	if !gameOver {
+		m.Turn = entity.TurnEnemy
+		m.NextAction = entity.NextActionEnemyAttack
+		m.NextActionAt = now.Add(s.aiDelay)
+	}
+	return events, nil
+}
+
+// EnemySalvo executa a salva da IA quando o schedule estiver liberado.
+// A IA escolhe todos os alvos antes de ver qualquer resultado (ai.FireSalvo).
+func (s *MatchService) EnemySalvo(m *entity.Match, now time.Time, aiPlayer *ai.AIPlayer) ([]entity.AttackEvent, error) {
+	if err := s.validateEnemyStep(m, now, aiPlayer); err != nil {
+		return nil, err
+	}
+	m.ClearNextAction()
+
+	cells := aiPlayer.FireSalvo(ai.NewBoardOracle(m.PlayerEntityBoard), s.SalvoShots(m, entity.TurnEnemy))
+
+	events := make([]entity.AttackEvent, 0, len(cells))
+	anyHit := false
+	for _, c := range cells {
+		hit := entity.WasHit(m.PlayerEntityBoard.Positions[c.Row][c.Col])
+
+		m.EnemyShots++
+		if hit {
+			anyHit = true
+			m.EnemyHits++
+			m.EnemyHitStreak++
+			if m.EnemyHitStreak > m.EnemyMaxHitStreak {
+				m.EnemyMaxHitStreak = m.EnemyHitStreak
+			}
+		} else {
+			m.EnemyHitStreak = 0
+		}
+
+		ev := s.makeEvent(entity.TurnEnemy, c.Row, c.Col, true, hit)
+		if hit {
+			s.fillSunk(&ev, m.PlayerEntityBoard, c.Row, c.Col)
+		}
+		if m.Record != nil {
+			m.Record.AddAttack(ev, now)
+		}
+		events = append(events, ev)
+	}
+
+	s.playSalvoSFX(anyHit)
+
+	// a salva inteira já foi aplicada no board; o fim de jogo é checado no final
+	if m.EnemyHits >= m.TotalPlayerShipCells && len(events) > 0 {
+		s.finishAndFillWinner(m, now, entity.TurnEnemy, &events[len(events)-1])
+		return events, nil
+	}
+	m.Turn = entity.TurnPlayer
+	return events, nil
+}
+
+// playSalvoSFX toca um único efeito para a salva inteira.
+func (s *MatchService) playSalvoSFX(anyHit bool) {
+	if anyHit {
+		s.playSFX("attack", 0.6)
+	} else {
+		s.playSFX("watersplash", 1)
+	}
+}